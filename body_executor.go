@@ -0,0 +1,10 @@
+package sqb
+
+import "io"
+
+// BodyExecutor is the optional capability of a StatementExecutor to send the statement followed by the data body,
+// e.g. INSERT INTO t FORMAT JSONEachRow with the encoded rows.
+type BodyExecutor interface {
+	// ExecWithBody executes the statement sending the data of the reader after the statement text.
+	ExecWithBody(sql string, params map[string]any, body io.Reader) (int64, error)
+}
@@ -0,0 +1,77 @@
+package clickhouse
+
+import (
+	"errors"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+	"github.com/AlephTav/sqb/execution"
+	"github.com/AlephTav/sqb/sql"
+	cls "github.com/AlephTav/sqb/sql/clause"
+)
+
+// AlterDeleteStmt is the ALTER TABLE ... DELETE mutation rewriting the data parts without the matched rows.
+// It fails without WHERE condition, Where("1") deletes all rows.
+type AlterDeleteStmt struct {
+	*execution.StatementExecution[*AlterDeleteStmt]
+	*sql.BaseStatement[*AlterDeleteStmt]
+	*clickhouse.AlterTableClause[*AlterDeleteStmt]
+	*clickhouse.OnClusterClause[*AlterDeleteStmt]
+	*clickhouse.InPartitionClause[*AlterDeleteStmt]
+	*cls.WhereClause[*AlterDeleteStmt]
+	*clickhouse.SettingsClause[*AlterDeleteStmt]
+}
+
+func NewAlterDeleteStmt(db sqb.StatementExecutor) *AlterDeleteStmt {
+	st := &AlterDeleteStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*AlterDeleteStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterDeleteStmt](st, db)
+	st.AlterTableClause = clickhouse.NewAlterTableClause[*AlterDeleteStmt](st)
+	st.OnClusterClause = clickhouse.NewOnClusterClause[*AlterDeleteStmt](st)
+	st.InPartitionClause = clickhouse.NewInPartitionClause[*AlterDeleteStmt](st)
+	st.WhereClause = cls.NewWhereClause[*AlterDeleteStmt](st)
+	st.SettingsClause = clickhouse.NewSettingsClause[*AlterDeleteStmt](st)
+	return st
+}
+
+func (s *AlterDeleteStmt) ItIsCommand() {}
+
+func (s *AlterDeleteStmt) Clean() *AlterDeleteStmt {
+	s.CleanAlterTable()
+	s.CleanOnCluster()
+	s.CleanInPartition()
+	s.CleanWhere()
+	s.CleanSettings()
+	return s
+}
+
+func (s *AlterDeleteStmt) Copy() *AlterDeleteStmt {
+	st := &AlterDeleteStmt{}
+	st.AlterTableClause = s.CopyAlterTable(st)
+	st.OnClusterClause = s.CopyOnCluster(st)
+	st.InPartitionClause = s.CopyInPartition(st)
+	st.WhereClause = s.CopyWhere(st)
+	st.SettingsClause = s.CopySettings(st)
+	st.StatementExecution = execution.NewStatementExecution[*AlterDeleteStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterDeleteStmt](st, s.Executor())
+	return st
+}
+
+func (s *AlterDeleteStmt) Build() *AlterDeleteStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildAlterTable()
+	s.BuildOnCluster()
+	s.AddSql(" DELETE")
+	s.BuildInPartition()
+	s.BuildWhere()
+	if !s.HasWhere() {
+		s.Fail(errors.New("ALTER TABLE DELETE requires WHERE condition, use Where(\"1\") to delete all rows"))
+	}
+	s.BuildSettings()
+	bindParameters(s, s.BaseStatement.Expression.Params())
+	s.Built()
+	return s
+}
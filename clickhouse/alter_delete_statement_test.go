@@ -0,0 +1,56 @@
+package clickhouse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlephTav/sqb"
+)
+
+func TestAlterDeleteStmt_Simple(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewAlterDeleteStmt(nil).
+		Table("visits").
+		Where("user_id", "IN", []any{1, 2})
+
+	sqb.CheckSql(t, "ALTER TABLE visits DELETE WHERE user_id IN (:p1, :p2)", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": 2}, st.Params())
+}
+
+func TestAlterDeleteStmt_OnClusterInPartitionWithSettings(t *testing.T) {
+	st := NewAlterDeleteStmt(nil).
+		Table("visits").
+		OnCluster("'{cluster}'").
+		InPartition("tuple('2024-01-01', 1)").
+		Where("1").
+		MutationsSync(1).
+		Settings("allow_nondeterministic_mutations = 1")
+
+	sqb.CheckSql(
+		t,
+		"ALTER TABLE visits ON CLUSTER '{cluster}' DELETE IN PARTITION tuple('2024-01-01', 1) "+
+			"WHERE 1 SETTINGS mutations_sync = 1, allow_nondeterministic_mutations = 1",
+		st.String(),
+	)
+}
+
+func TestAlterDeleteStmt_Copy(t *testing.T) {
+	st := NewAlterDeleteStmt(nil).Table("visits").InPartitionId("it's").Where("a = 1")
+	copied := st.Copy().CleanInPartition().Table("hits")
+
+	sqb.CheckSql(t, "ALTER TABLE visits DELETE IN PARTITION ID 'it''s' WHERE a = 1", st.String())
+	sqb.CheckSql(t, "ALTER TABLE hits DELETE WHERE a = 1", copied.String())
+}
+
+func TestAlterDeleteStmt_WithoutWhere(t *testing.T) {
+	st := NewAlterDeleteStmt(sqb.NewStatementExecutorMock()).
+		Table("visits").
+		InPartition("202401")
+
+	if _, err := st.Exec(); err == nil || !strings.Contains(err.Error(), "requires WHERE condition") {
+		t.Errorf("Exec() error = %v, expected the error about the missing WHERE condition", err)
+	}
+	if err := st.Where("1").Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
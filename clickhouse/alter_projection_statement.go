@@ -0,0 +1,69 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+	"github.com/AlephTav/sqb/execution"
+	"github.com/AlephTav/sqb/sql"
+)
+
+// AlterProjectionStmt is the ALTER TABLE statement adding, materializing, clearing and dropping table projections.
+type AlterProjectionStmt struct {
+	*execution.StatementExecution[*AlterProjectionStmt]
+	*sql.BaseStatement[*AlterProjectionStmt]
+	*clickhouse.AlterTableClause[*AlterProjectionStmt]
+	*clickhouse.OnClusterClause[*AlterProjectionStmt]
+	*clickhouse.ProjectionClause[*AlterProjectionStmt]
+	*clickhouse.InPartitionClause[*AlterProjectionStmt]
+	*clickhouse.SettingsClause[*AlterProjectionStmt]
+}
+
+func NewAlterProjectionStmt(db sqb.StatementExecutor) *AlterProjectionStmt {
+	st := &AlterProjectionStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*AlterProjectionStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterProjectionStmt](st, db)
+	st.AlterTableClause = clickhouse.NewAlterTableClause[*AlterProjectionStmt](st)
+	st.OnClusterClause = clickhouse.NewOnClusterClause[*AlterProjectionStmt](st)
+	st.ProjectionClause = clickhouse.NewProjectionClause[*AlterProjectionStmt](st)
+	st.InPartitionClause = clickhouse.NewInPartitionClause[*AlterProjectionStmt](st)
+	st.SettingsClause = clickhouse.NewSettingsClause[*AlterProjectionStmt](st)
+	return st
+}
+
+func (s *AlterProjectionStmt) ItIsCommand() {}
+
+func (s *AlterProjectionStmt) Clean() *AlterProjectionStmt {
+	s.CleanAlterTable()
+	s.CleanOnCluster()
+	s.CleanProjection()
+	s.CleanInPartition()
+	s.CleanSettings()
+	return s
+}
+
+func (s *AlterProjectionStmt) Copy() *AlterProjectionStmt {
+	st := &AlterProjectionStmt{}
+	st.AlterTableClause = s.CopyAlterTable(st)
+	st.OnClusterClause = s.CopyOnCluster(st)
+	st.ProjectionClause = s.CopyProjection(st)
+	st.InPartitionClause = s.CopyInPartition(st)
+	st.SettingsClause = s.CopySettings(st)
+	st.StatementExecution = execution.NewStatementExecution[*AlterProjectionStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterProjectionStmt](st, s.Executor())
+	return st
+}
+
+func (s *AlterProjectionStmt) Build() *AlterProjectionStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildAlterTable()
+	s.BuildOnCluster()
+	s.BuildProjection()
+	s.BuildInPartition()
+	s.BuildSettings()
+	bindParameters(s, s.BaseStatement.Expression.Params())
+	s.Built()
+	return s
+}
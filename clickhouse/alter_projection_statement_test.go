@@ -0,0 +1,55 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/AlephTav/sqb"
+)
+
+func TestAlterProjectionStmt_AddProjection(t *testing.T) {
+	st := NewAlterProjectionStmt(nil).
+		Table("visits").
+		OnCluster("main").
+		AddProjection("p_user", NewSelectStmt(nil).Select("user_id, count()").GroupBy("user_id")).
+		AddProjection("p_sorted", NewSelectStmt(nil).Select("*").OrderBy("ts"), true)
+
+	sqb.CheckSql(
+		t,
+		"ALTER TABLE visits ON CLUSTER main "+
+			"ADD PROJECTION p_user (SELECT user_id, count() GROUP BY user_id), "+
+			"ADD PROJECTION IF NOT EXISTS p_sorted (SELECT * ORDER BY ts)",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestAlterProjectionStmt_MaterializeProjection(t *testing.T) {
+	st := NewAlterProjectionStmt(nil).
+		Table("visits").
+		MaterializeProjection("p_user").
+		InPartition("202401").
+		MutationsSync(2)
+
+	sqb.CheckSql(
+		t,
+		"ALTER TABLE visits MATERIALIZE PROJECTION p_user IN PARTITION 202401 SETTINGS mutations_sync = 2",
+		st.String(),
+	)
+}
+
+func TestAlterProjectionStmt_ClearAndDropProjection(t *testing.T) {
+	st := NewAlterProjectionStmt(nil).
+		Table("visits").
+		ClearProjection("p_old", true).
+		DropProjection("p_user").
+		DropProjection("p_sorted", true)
+
+	sqb.CheckSql(
+		t,
+		"ALTER TABLE visits CLEAR PROJECTION IF EXISTS p_old, DROP PROJECTION p_user, DROP PROJECTION IF EXISTS p_sorted",
+		st.String(),
+	)
+
+	copied := st.Copy().CleanProjection().DropProjection("p")
+	sqb.CheckSql(t, "ALTER TABLE visits DROP PROJECTION p", copied.String())
+}
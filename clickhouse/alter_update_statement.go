@@ -0,0 +1,81 @@
+package clickhouse
+
+import (
+	"errors"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+	"github.com/AlephTav/sqb/execution"
+	"github.com/AlephTav/sqb/sql"
+	cls "github.com/AlephTav/sqb/sql/clause"
+)
+
+// AlterUpdateStmt is the ALTER TABLE ... UPDATE mutation rewriting the data parts with the matched rows.
+// It fails without WHERE condition, Where("1") updates all rows.
+type AlterUpdateStmt struct {
+	*execution.StatementExecution[*AlterUpdateStmt]
+	*sql.BaseStatement[*AlterUpdateStmt]
+	*clickhouse.AlterTableClause[*AlterUpdateStmt]
+	*clickhouse.OnClusterClause[*AlterUpdateStmt]
+	*cls.AssignmentClause[*AlterUpdateStmt]
+	*clickhouse.InPartitionClause[*AlterUpdateStmt]
+	*cls.WhereClause[*AlterUpdateStmt]
+	*clickhouse.SettingsClause[*AlterUpdateStmt]
+}
+
+func NewAlterUpdateStmt(db sqb.StatementExecutor) *AlterUpdateStmt {
+	st := &AlterUpdateStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*AlterUpdateStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterUpdateStmt](st, db)
+	st.AlterTableClause = clickhouse.NewAlterTableClause[*AlterUpdateStmt](st)
+	st.OnClusterClause = clickhouse.NewOnClusterClause[*AlterUpdateStmt](st)
+	st.AssignmentClause = cls.NewAssignmentClause[*AlterUpdateStmt](st)
+	st.InPartitionClause = clickhouse.NewInPartitionClause[*AlterUpdateStmt](st)
+	st.WhereClause = cls.NewWhereClause[*AlterUpdateStmt](st)
+	st.SettingsClause = clickhouse.NewSettingsClause[*AlterUpdateStmt](st)
+	return st
+}
+
+func (s *AlterUpdateStmt) ItIsCommand() {}
+
+func (s *AlterUpdateStmt) Clean() *AlterUpdateStmt {
+	s.CleanAlterTable()
+	s.CleanOnCluster()
+	s.CleanAssignment()
+	s.CleanInPartition()
+	s.CleanWhere()
+	s.CleanSettings()
+	return s
+}
+
+func (s *AlterUpdateStmt) Copy() *AlterUpdateStmt {
+	st := &AlterUpdateStmt{}
+	st.AlterTableClause = s.CopyAlterTable(st)
+	st.OnClusterClause = s.CopyOnCluster(st)
+	st.AssignmentClause = s.CopyAssignment(st)
+	st.InPartitionClause = s.CopyInPartition(st)
+	st.WhereClause = s.CopyWhere(st)
+	st.SettingsClause = s.CopySettings(st)
+	st.StatementExecution = execution.NewStatementExecution[*AlterUpdateStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterUpdateStmt](st, s.Executor())
+	return st
+}
+
+func (s *AlterUpdateStmt) Build() *AlterUpdateStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildAlterTable()
+	s.BuildOnCluster()
+	s.BuildAssignmentAs("UPDATE")
+	s.BuildInPartition()
+	s.BuildWhere()
+	if !s.HasWhere() {
+		s.Fail(errors.New("ALTER TABLE UPDATE requires WHERE condition, use Where(\"1\") to update all rows"))
+	}
+	s.BuildSettings()
+	bindParameters(s, s.BaseStatement.Expression.Params())
+	s.Built()
+	return s
+}
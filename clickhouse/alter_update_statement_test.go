@@ -0,0 +1,84 @@
+package clickhouse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+func TestAlterUpdateStmt_Simple(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewAlterUpdateStmt(nil).
+		Table("visits").
+		Assign("status", "archived").
+		Assign("updated_at", sql.NewExp("now()")).
+		Where("created_at", "<", "2024-01-01")
+
+	sqb.CheckSql(t, "ALTER TABLE visits UPDATE status = :p1, updated_at = now() WHERE created_at < :p2", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": "archived", "p2": "2024-01-01"}, st.Params())
+}
+
+func TestAlterUpdateStmt_OnClusterInPartitionWithSettings(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewAlterUpdateStmt(nil).
+		Table("db.visits").
+		OnCluster("main").
+		Assign("hits", sql.NewExp("hits + 1")).
+		InPartition("202401").
+		Where("user_id", "=", 7).
+		MutationsSync(2)
+
+	sqb.CheckSql(
+		t,
+		"ALTER TABLE db.visits ON CLUSTER main UPDATE hits = hits + 1 IN PARTITION 202401 "+
+			"WHERE user_id = :p1 SETTINGS mutations_sync = 2",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 7}, st.Params())
+}
+
+func TestAlterUpdateStmt_CopyAndClean(t *testing.T) {
+	st := NewAlterUpdateStmt(nil).
+		Table("visits").
+		OnCluster("main").
+		Assign("a", sql.NewExp("1")).
+		InPartitionId("all_1_1_0").
+		Where("b = 2")
+
+	copied := st.Copy().CleanOnCluster()
+
+	sqb.CheckSql(t, "ALTER TABLE visits ON CLUSTER main UPDATE a = 1 IN PARTITION ID 'all_1_1_0' WHERE b = 2", st.String())
+	sqb.CheckSql(t, "ALTER TABLE visits UPDATE a = 1 IN PARTITION ID 'all_1_1_0' WHERE b = 2", copied.String())
+	sqb.CheckSql(t, "ALTER TABLE", st.Clean().String())
+}
+
+func TestAlterUpdateStmt_Exec(t *testing.T) {
+	affected, err := NewAlterUpdateStmt(sqb.NewStatementExecutorMock()).
+		Table("visits").
+		Assign("a", 1).
+		Where("b = 2").
+		Exec()
+
+	if err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if affected != 3 {
+		t.Errorf("Expected 3 affected rows, actual is %d", affected)
+	}
+}
+
+func TestAlterUpdateStmt_WithoutWhere(t *testing.T) {
+	st := NewAlterUpdateStmt(sqb.NewStatementExecutorMock()).
+		Table("visits").
+		Assign("a", 1).
+		InPartition("202401")
+
+	if _, err := st.Exec(); err == nil || !strings.Contains(err.Error(), "requires WHERE condition") {
+		t.Errorf("Exec() error = %v, expected the error about the missing WHERE condition", err)
+	}
+	if err := st.Where("1").Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+type AlterTableClause[T sqb.Statement[T]] struct {
+	self T
+	exp  sql.DirectListExpression
+}
+
+func NewAlterTableClause[T sqb.Statement[T]](self T) *AlterTableClause[T] {
+	return &AlterTableClause[T]{self, sql.EmptyDirectListExp()}
+}
+
+// Table sets the table of the ALTER TABLE statement.
+func (a *AlterTableClause[T]) Table(table any) T {
+	a.exp.Clean()
+	a.exp.Append(table)
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AlterTableClause[T]) CleanAlterTable() T {
+	a.exp.Clean()
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AlterTableClause[T]) CopyAlterTable(self T) *AlterTableClause[T] {
+	return &AlterTableClause[T]{self, a.exp.Copy()}
+}
+
+func (a *AlterTableClause[T]) BuildAlterTable() T {
+	if a.exp.IsEmpty() {
+		a.self.AddSql("ALTER TABLE")
+	} else {
+		a.self.AddParams(a.exp.Params())
+		a.self.AddSql("ALTER TABLE ")
+		a.self.AddSql(a.exp.String())
+	}
+	return a.self
+}
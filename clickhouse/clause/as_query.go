@@ -0,0 +1,59 @@
+package clickhouse
+
+import (
+	"fmt"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// AsQueryClause is the AS SELECT body of the CREATE VIEW statements.
+// The body is DDL, so the statement fails if the query has parameters or is invalid.
+type AsQueryClause[T sqb.Statement[T], Q sqb.QueryStmt[Q]] struct {
+	self     T
+	query    Q
+	hasQuery bool
+}
+
+func NewAsQueryClause[T sqb.Statement[T], Q sqb.QueryStmt[Q]](self T) *AsQueryClause[T, Q] {
+	return &AsQueryClause[T, Q]{self: self}
+}
+
+// As sets the query of the view.
+func (a *AsQueryClause[T, Q]) As(query Q) T {
+	a.query = query
+	a.hasQuery = true
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AsQueryClause[T, Q]) CleanAsQuery() T {
+	var query Q
+	a.query = query
+	a.hasQuery = false
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AsQueryClause[T, Q]) CopyAsQuery(self T) *AsQueryClause[T, Q] {
+	c := &AsQueryClause[T, Q]{self: self, hasQuery: a.hasQuery}
+	if a.hasQuery {
+		c.query = a.query.Copy()
+	}
+	return c
+}
+
+func (a *AsQueryClause[T, Q]) BuildAsQuery() T {
+	if a.hasQuery {
+		params := sql.NestedParams(a.query)
+		if err := sql.PopNestedError(params); err != nil {
+			sqb.Fail(a.self, err)
+		}
+		if len(params) > 0 {
+			sqb.Fail(a.self, fmt.Errorf("view query %q cannot have parameters, use SQL literals instead", a.query.String()))
+		}
+		a.self.AddSql(" AS ")
+		a.self.AddSql(a.query.String())
+	}
+	return a.self
+}
@@ -0,0 +1,29 @@
+package clickhouse
+
+import "strings"
+
+// Nullable returns the data type allowing NULL values, e.g. Nullable(String).
+func Nullable(dataType string) string {
+	return "Nullable(" + dataType + ")"
+}
+
+// LowCardinality returns the dictionary-encoded data type, e.g. LowCardinality(String).
+func LowCardinality(dataType string) string {
+	return "LowCardinality(" + dataType + ")"
+}
+
+// Array returns the array data type, e.g. Array(UInt32).
+func Array(dataType string) string {
+	return "Array(" + dataType + ")"
+}
+
+// Map returns the map data type, e.g. Map(String, UInt64).
+func Map(keyType string, valueType string) string {
+	return "Map(" + keyType + ", " + valueType + ")"
+}
+
+// Tuple returns the tuple data type, the elements are types or named elements,
+// e.g. Tuple("a String", "b UInt8") is Tuple(a String, b UInt8).
+func Tuple(elements ...string) string {
+	return "Tuple(" + strings.Join(elements, ", ") + ")"
+}
@@ -0,0 +1,30 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/clause"
+)
+
+type DeleteClause[T sqb.Statement[T]] struct {
+	*sql.DeleteClause[T]
+}
+
+func NewDeleteClause[T sqb.Statement[T]](self T) *DeleteClause[T] {
+	return &DeleteClause[T]{sql.NewDeleteClause[T](self)}
+}
+
+func (d *DeleteClause[T]) CopyDelete(self T) *DeleteClause[T] {
+	return &DeleteClause[T]{d.DeleteClause.CopyDelete(self)}
+}
+
+func (d *DeleteClause[T]) BuildDelete() T {
+	self, exp := d.DeleteClause.BuildDelete()
+	if exp.IsEmpty() {
+		self.AddSql("DELETE FROM")
+	} else {
+		self.AddParams(exp.Params())
+		self.AddSql("DELETE FROM ")
+		self.AddSql(exp.String())
+	}
+	return self
+}
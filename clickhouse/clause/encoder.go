@@ -0,0 +1,493 @@
+package clickhouse
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlephTav/sqb"
+)
+
+// Encoder encodes the inserted rows in the ClickHouse input format sent in the body of INSERT ... FORMAT statements.
+type Encoder interface {
+	// Format returns the name of the input format.
+	Format() string
+	// Encode writes the rows, every row contains the values of the columns in the same order.
+	Encode(w io.Writer, columns []string, rows [][]any) error
+}
+
+// The text encoders write time.Time as the date and time in UTC, so the DateTime and DateTime64 columns
+// must have the UTC time zone, e.g. DateTime64(9, 'UTC'), unless the server time zone is UTC.
+var (
+	// JSONEachRow writes every row as the JSON object on a separate line.
+	JSONEachRow Encoder = jsonEachRowEncoder{}
+	// CSVWithNames writes the header with the column names and the comma separated rows, NULL is \N.
+	CSVWithNames Encoder = csvWithNamesEncoder{}
+	// TabSeparated writes the tab separated rows with escaped special characters, NULL is \N.
+	TabSeparated Encoder = tabSeparatedEncoder{}
+	// RowBinary writes the rows in the binary format, the Go types of the values must match the column types
+	// as they are inferred for the typed parameters, e.g. int is Int64, pointers are Nullable.
+	// Untyped nil cannot be encoded since the column type is unknown, the nil pointer is NULL of Nullable column.
+	RowBinary Encoder = rowBinaryEncoder{}
+)
+
+type jsonEachRowEncoder struct{}
+
+func (e jsonEachRowEncoder) Format() string {
+	return "JSONEachRow"
+}
+
+func (e jsonEachRowEncoder) Encode(w io.Writer, columns []string, rows [][]any) error {
+	var buf []byte
+	for _, row := range rows {
+		buf = append(buf[:0], '{')
+		for i, column := range columns {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, column)
+			buf = append(buf, ':')
+			buf = appendJSON(buf, reflect.ValueOf(row[i]))
+		}
+		buf = append(buf, '}', '\n')
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type csvWithNamesEncoder struct{}
+
+func (e csvWithNamesEncoder) Format() string {
+	return "CSVWithNames"
+}
+
+func (e csvWithNamesEncoder) Encode(w io.Writer, columns []string, rows [][]any) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, value := range row {
+			record[i] = string(appendText(nil, reflect.ValueOf(value), false))
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+type tabSeparatedEncoder struct{}
+
+func (e tabSeparatedEncoder) Format() string {
+	return "TabSeparated"
+}
+
+var tabSeparatedEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"\t", `\t`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\x00", `\0`,
+)
+
+func (e tabSeparatedEncoder) Encode(w io.Writer, columns []string, rows [][]any) error {
+	var buf []byte
+	for _, row := range rows {
+		buf = buf[:0]
+		for i, value := range row {
+			if i > 0 {
+				buf = append(buf, '\t')
+			}
+			buf = appendEscaped(buf, reflect.ValueOf(value))
+		}
+		buf = append(buf, '\n')
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EscapedText returns the value in the escaped text format of the TabSeparated fields,
+// e.g. it is the format of the typed parameter values passed to the server.
+func EscapedText(value any) string {
+	return string(appendEscaped(nil, reflect.ValueOf(value)))
+}
+
+func appendEscaped(buf []byte, v reflect.Value) []byte {
+	if isTextString(v) {
+		return append(buf, tabSeparatedEscaper.Replace(string(appendText(nil, v, false)))...)
+	}
+	return appendText(buf, v, false)
+}
+
+type rowBinaryEncoder struct{}
+
+func (e rowBinaryEncoder) Format() string {
+	return "RowBinary"
+}
+
+func (e rowBinaryEncoder) Encode(w io.Writer, columns []string, rows [][]any) error {
+	var buf []byte
+	var err error
+	for _, row := range rows {
+		buf = buf[:0]
+		for _, value := range row {
+			if buf, err = appendBinary(buf, reflect.ValueOf(value)); err != nil {
+				return err
+			}
+		}
+		if _, err = w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf([16]byte{})
+)
+
+const timeLayout = "2006-01-02 15:04:05.999999999"
+
+func isUUID(t reflect.Type) bool {
+	return t.Kind() == reflect.Array && t.ConvertibleTo(uuidType)
+}
+
+func uuidToString(v reflect.Value) string {
+	id := v.Convert(uuidType).Interface().([16]byte)
+	s := hex.EncodeToString(id[:])
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+// isTextString returns true if the value is written as the string, not as NULL, number or composite value.
+func isTextString(v reflect.Value) bool {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return false
+	}
+	return v.Kind() == reflect.String || v.Type() == timeType || isUUID(v.Type()) ||
+		v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8
+}
+
+// appendText appends the text representation of the value, the strings of composite values are always quoted.
+func appendText(buf []byte, v reflect.Value, quoted bool) []byte {
+	if !v.IsValid() {
+		if quoted {
+			return append(buf, "NULL"...)
+		}
+		return append(buf, `\N`...)
+	}
+	if v.Type() == timeType {
+		return appendTextString(buf, v.Interface().(time.Time).UTC().Format(timeLayout), quoted)
+	}
+	if isUUID(v.Type()) {
+		return appendTextString(buf, uuidToString(v), quoted)
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return appendText(buf, reflect.Value{}, quoted)
+		}
+		return appendText(buf, v.Elem(), quoted)
+	case reflect.Bool:
+		return strconv.AppendBool(buf, v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(buf, v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(buf, v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.AppendFloat(buf, v.Float(), 'g', -1, 32)
+	case reflect.Float64:
+		return strconv.AppendFloat(buf, v.Float(), 'g', -1, 64)
+	case reflect.String:
+		return appendTextString(buf, v.String(), quoted)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return appendTextString(buf, string(v.Bytes()), quoted)
+		}
+		buf = append(buf, '[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendText(buf, v.Index(i), true)
+		}
+		return append(buf, ']')
+	case reflect.Map:
+		buf = append(buf, '{')
+		for i, key := range sortedMapKeys(v) {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendText(buf, key, true)
+			buf = append(buf, ':')
+			buf = appendText(buf, v.MapIndex(key), true)
+		}
+		return append(buf, '}')
+	default:
+		return appendTextString(buf, fmt.Sprint(v.Interface()), quoted)
+	}
+}
+
+var quotedStringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"'", `\'`,
+	"\t", `\t`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\x00", `\0`,
+)
+
+func appendTextString(buf []byte, s string, quoted bool) []byte {
+	if !quoted {
+		return append(buf, s...)
+	}
+	buf = append(buf, '\'')
+	buf = append(buf, quotedStringEscaper.Replace(s)...)
+	return append(buf, '\'')
+}
+
+func appendJSON(buf []byte, v reflect.Value) []byte {
+	if !v.IsValid() {
+		return append(buf, "null"...)
+	}
+	if v.Type() == timeType || isUUID(v.Type()) {
+		return appendJSONString(buf, string(appendText(nil, v, false)))
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return append(buf, "null"...)
+		}
+		return appendJSON(buf, v.Elem())
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return appendText(buf, v, false)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return appendJSONString(buf, string(v.Bytes()))
+		}
+		buf = append(buf, '[')
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSON(buf, v.Index(i))
+		}
+		return append(buf, ']')
+	case reflect.Map:
+		buf = append(buf, '{')
+		for i, key := range sortedMapKeys(v) {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONString(buf, string(appendText(nil, key, false)))
+			buf = append(buf, ':')
+			buf = appendJSON(buf, v.MapIndex(key))
+		}
+		return append(buf, '}')
+	default:
+		return appendJSONString(buf, string(appendText(nil, v, false)))
+	}
+}
+
+func appendJSONString(buf []byte, s string) []byte {
+	data, _ := json.Marshal(s)
+	return append(buf, data...)
+}
+
+// appendBinary appends the RowBinary representation of the value, nil values are written as NULL of Nullable columns.
+func appendBinary(buf []byte, v reflect.Value) ([]byte, error) {
+	if !v.IsValid() {
+		return nil, errors.New("nil cannot be encoded in RowBinary format, use the nil pointer for Nullable column")
+	}
+	if v.Type() == timeType {
+		return binary.LittleEndian.AppendUint64(buf, uint64(v.Interface().(time.Time).UnixNano())), nil
+	}
+	if isUUID(v.Type()) {
+		id := v.Convert(uuidType).Interface().([16]byte)
+		for i := 7; i >= 0; i-- {
+			buf = append(buf, id[i])
+		}
+		for i := 15; i >= 8; i-- {
+			buf = append(buf, id[i])
+		}
+		return buf, nil
+	}
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return append(buf, 1), nil
+		}
+		return appendBinary(append(buf, 0), v.Elem())
+	case reflect.Interface:
+		return appendBinary(buf, v.Elem())
+	case reflect.Bool:
+		if v.Bool() {
+			return append(buf, 1), nil
+		}
+		return append(buf, 0), nil
+	case reflect.Int8:
+		return append(buf, byte(v.Int())), nil
+	case reflect.Int16:
+		return binary.LittleEndian.AppendUint16(buf, uint16(v.Int())), nil
+	case reflect.Int32:
+		return binary.LittleEndian.AppendUint32(buf, uint32(v.Int())), nil
+	case reflect.Int, reflect.Int64:
+		return binary.LittleEndian.AppendUint64(buf, uint64(v.Int())), nil
+	case reflect.Uint8:
+		return append(buf, byte(v.Uint())), nil
+	case reflect.Uint16:
+		return binary.LittleEndian.AppendUint16(buf, uint16(v.Uint())), nil
+	case reflect.Uint32:
+		return binary.LittleEndian.AppendUint32(buf, uint32(v.Uint())), nil
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return binary.LittleEndian.AppendUint64(buf, v.Uint()), nil
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(v.Float()))), nil
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v.Float())), nil
+	case reflect.String:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		return append(buf, v.String()...), nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			buf = binary.AppendUvarint(buf, uint64(v.Len()))
+			return append(buf, v.Bytes()...), nil
+		}
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		var err error
+		for i := 0; i < v.Len(); i++ {
+			if buf, err = appendBinary(buf, v.Index(i)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	case reflect.Map:
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		var err error
+		for _, key := range sortedMapKeys(v) {
+			if buf, err = appendBinary(buf, key); err != nil {
+				return nil, err
+			}
+			if buf, err = appendBinary(buf, v.MapIndex(key)); err != nil {
+				return nil, err
+			}
+		}
+		return buf, nil
+	default:
+		return nil, fmt.Errorf("value of type %s cannot be encoded in RowBinary format", v.Type())
+	}
+}
+
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	keys := v.MapKeys()
+	slices.SortFunc(keys, func(a, b reflect.Value) int {
+		return strings.Compare(string(appendText(nil, a, false)), string(appendText(nil, b, false)))
+	})
+	return keys
+}
+
+// BodyClause holds the rows sent in the body of the statement encoded in the input format of the encoder.
+type BodyClause[T sqb.Statement[T]] struct {
+	self    T
+	encoder Encoder
+	columns []string
+	rows    [][]any
+	err     error
+}
+
+func NewBodyClause[T sqb.Statement[T]](self T) *BodyClause[T] {
+	return &BodyClause[T]{self: self}
+}
+
+// EncodeBody sets the rows of the body, the rows are a slice of maps or structs having the same columns,
+// the columns of the structs are defined as in sqb.StructToSliceMap.
+func (b *BodyClause[T]) EncodeBody(encoder Encoder, rows any) T {
+	b.encoder = encoder
+	b.columns, b.rows, b.err = encodedRows(rows)
+	b.self.Dirty()
+	return b.self
+}
+
+// BodyColumns returns the columns of the body rows.
+func (b *BodyClause[T]) BodyColumns() []string {
+	return b.columns
+}
+
+// Body returns the encoded rows or the error of the rows conversion and encoding.
+func (b *BodyClause[T]) Body() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.encoder == nil {
+		return nil, nil
+	}
+	var body bytes.Buffer
+	if err := b.encoder.Encode(&body, b.columns, b.rows); err != nil {
+		return nil, err
+	}
+	return body.Bytes(), nil
+}
+
+func (b *BodyClause[T]) CleanBody() T {
+	b.encoder = nil
+	b.columns = nil
+	b.rows = nil
+	b.err = nil
+	b.self.Dirty()
+	return b.self
+}
+
+func (b *BodyClause[T]) CopyBody(self T) *BodyClause[T] {
+	return &BodyClause[T]{self, b.encoder, b.columns, b.rows, b.err}
+}
+
+func encodedRows(rows any) ([]string, [][]any, error) {
+	maps := sqb.ToSliceMaps(rows)
+	if maps == nil {
+		return nil, nil, fmt.Errorf("rows of type %T cannot be encoded, a slice of maps or structs is expected", rows)
+	}
+	if len(maps) == 0 {
+		return nil, nil, nil
+	}
+	var columns []string
+	for _, key := range sqb.Keys(maps[0]) {
+		columns = append(columns, fmt.Sprintf("%s", key))
+	}
+	result := make([][]any, 0, len(maps))
+	for i, row := range maps {
+		keys := sqb.Keys(row)
+		if len(keys) != len(columns) {
+			return nil, nil, fmt.Errorf("row %d has %d columns, expected %d", i, len(keys), len(columns))
+		}
+		for j, key := range keys {
+			if fmt.Sprintf("%s", key) != columns[j] {
+				return nil, nil, fmt.Errorf("row %d has column %s instead of %s", i, key, columns[j])
+			}
+		}
+		result = append(result, sqb.Values(row))
+	}
+	return columns, result, nil
+}
@@ -0,0 +1,251 @@
+package clickhouse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// TableEngine is the table engine with its parameters, e.g. ReplacingMergeTree(ver).
+type TableEngine struct {
+	name   string
+	params []string
+	err    error
+}
+
+func (e TableEngine) String() string {
+	if len(e.params) == 0 {
+		return e.name
+	}
+	return e.name + "(" + strings.Join(e.params, ", ") + ")"
+}
+
+func MergeTree() TableEngine {
+	return engine("MergeTree", nil)
+}
+
+// ReplacingMergeTree returns the engine removing duplicates with the same sorting key:
+//   - ReplacingMergeTree()
+//   - ReplacingMergeTree(version string)
+//   - ReplacingMergeTree(version string, isDeleted string)
+func ReplacingMergeTree(args ...string) TableEngine {
+	return engine("ReplacingMergeTree", args)
+}
+
+// SummingMergeTree returns the engine summing the numeric columns of rows with the same sorting key:
+//   - SummingMergeTree()
+//   - SummingMergeTree(columns ...string)
+func SummingMergeTree(columns ...string) TableEngine {
+	if len(columns) == 0 {
+		return engine("SummingMergeTree", nil)
+	}
+	return engine("SummingMergeTree", []string{"(" + strings.Join(columns, ", ") + ")"})
+}
+
+func AggregatingMergeTree() TableEngine {
+	return engine("AggregatingMergeTree", nil)
+}
+
+func CollapsingMergeTree(sign string) TableEngine {
+	return engine("CollapsingMergeTree", []string{sign})
+}
+
+func VersionedCollapsingMergeTree(sign string, version string) TableEngine {
+	return engine("VersionedCollapsingMergeTree", []string{sign, version})
+}
+
+// ReplicatedMergeTree returns the replicated version of the MergeTree family engine:
+//   - ReplicatedMergeTree(path string, replica string) is ReplicatedMergeTree('path', 'replica')
+//   - ReplicatedMergeTree(path string, replica string, engine TableEngine), e.g. engine is ReplacingMergeTree("ver")
+//
+// The statement using the engine fails if the engine is not a MergeTree family engine.
+func ReplicatedMergeTree(path string, replica string, args ...TableEngine) TableEngine {
+	base := MergeTree()
+	if len(args) > 0 {
+		base = args[0]
+	}
+	replicated := engine("Replicated"+base.name, append([]string{quoteString(path), quoteString(replica)}, base.params...))
+	replicated.err = base.err
+	if !strings.HasSuffix(base.name, "MergeTree") || strings.HasPrefix(base.name, "Replicated") {
+		replicated.err = fmt.Errorf("engine %s has no replicated version", base.name)
+	}
+	return replicated
+}
+
+// Distributed returns the engine of the table distributed over the cluster shards:
+//   - Distributed(cluster string, database string, table string)
+//   - Distributed(cluster string, database string, table string, shardingKey string)
+func Distributed(cluster string, database string, table string, args ...string) TableEngine {
+	return engine("Distributed", append([]string{cluster, database, table}, args...))
+}
+
+func Memory() TableEngine {
+	return engine("Memory", nil)
+}
+
+// Null returns the engine discarding the inserted data, it is used as a source of materialized views.
+func Null() TableEngine {
+	return engine("Null", nil)
+}
+
+func engine(name string, params []string) TableEngine {
+	return TableEngine{name, params, nil}
+}
+
+// EngineClause is the table engine with its ORDER BY, PARTITION BY, PRIMARY KEY, SAMPLE BY and TTL clauses.
+type EngineClause[T sqb.Statement[T]] struct {
+	self        T
+	err         error
+	engine      string
+	orderBy     []string
+	orderByUsed bool
+	partitionBy string
+	primaryKey  []string
+	sampleBy    string
+	ttl         []string
+}
+
+func NewEngineClause[T sqb.Statement[T]](self T) *EngineClause[T] {
+	return &EngineClause[T]{self: self}
+}
+
+// Engine sets the table engine, e.g. Engine(ReplacingMergeTree("ver")) or Engine("Log").
+func (e *EngineClause[T]) Engine(engine any) T {
+	if te, ok := engine.(TableEngine); ok {
+		e.fail(te.err)
+		e.engine = te.String()
+	} else {
+		e.engine = e.ddl(engine)
+	}
+	e.self.Dirty()
+	return e.self
+}
+
+// OrderBy adds the columns or expressions to the sorting key, the empty key is ORDER BY tuple().
+func (e *EngineClause[T]) OrderBy(columns ...any) T {
+	e.orderByUsed = true
+	for _, column := range columns {
+		e.orderBy = append(e.orderBy, e.ddl(column))
+	}
+	e.self.Dirty()
+	return e.self
+}
+
+// PartitionBy sets the partitioning key, e.g. PartitionBy("toYYYYMM(d)").
+func (e *EngineClause[T]) PartitionBy(expression any) T {
+	e.partitionBy = e.ddl(expression)
+	e.self.Dirty()
+	return e.self
+}
+
+// PrimaryKey adds the columns or expressions to the primary key if it differs from the sorting key.
+func (e *EngineClause[T]) PrimaryKey(columns ...any) T {
+	for _, column := range columns {
+		e.primaryKey = append(e.primaryKey, e.ddl(column))
+	}
+	e.self.Dirty()
+	return e.self
+}
+
+// SampleBy sets the sampling expression, it must be a part of the primary key.
+func (e *EngineClause[T]) SampleBy(expression any) T {
+	e.sampleBy = e.ddl(expression)
+	e.self.Dirty()
+	return e.self
+}
+
+// TTL adds the rule of the table data expiration, e.g. TTL("d + INTERVAL 1 MONTH DELETE").
+func (e *EngineClause[T]) TTL(rule any) T {
+	e.ttl = append(e.ttl, e.ddl(rule))
+	e.self.Dirty()
+	return e.self
+}
+
+// HasEngine returns true if the engine, any of its keys or TTL is set.
+func (e *EngineClause[T]) HasEngine() bool {
+	return e.engine != "" || e.orderByUsed || e.partitionBy != "" || len(e.primaryKey) > 0 || e.sampleBy != "" ||
+		len(e.ttl) > 0
+}
+
+func (e *EngineClause[T]) ddl(value any) string {
+	ddl, err := sql.DdlToString(value)
+	e.fail(err)
+	return ddl
+}
+
+func (e *EngineClause[T]) fail(err error) {
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *EngineClause[T]) CleanEngine() T {
+	e.err = nil
+	e.engine = ""
+	e.orderBy = nil
+	e.orderByUsed = false
+	e.partitionBy = ""
+	e.primaryKey = nil
+	e.sampleBy = ""
+	e.ttl = nil
+	e.self.Dirty()
+	return e.self
+}
+
+func (e *EngineClause[T]) CopyEngine(self T) *EngineClause[T] {
+	return &EngineClause[T]{
+		self:        self,
+		err:         e.err,
+		engine:      e.engine,
+		orderBy:     append([]string(nil), e.orderBy...),
+		orderByUsed: e.orderByUsed,
+		partitionBy: e.partitionBy,
+		primaryKey:  append([]string(nil), e.primaryKey...),
+		sampleBy:    e.sampleBy,
+		ttl:         append([]string(nil), e.ttl...),
+	}
+}
+
+func (e *EngineClause[T]) BuildEngine() T {
+	if e.err != nil {
+		sqb.Fail(e.self, e.err)
+	}
+	if e.engine != "" {
+		e.self.AddSql(" ENGINE = ")
+		e.self.AddSql(e.engine)
+	}
+	if e.orderByUsed {
+		e.self.AddSql(" ORDER BY ")
+		e.self.AddSql(keyToString(e.orderBy))
+	}
+	if e.partitionBy != "" {
+		e.self.AddSql(" PARTITION BY ")
+		e.self.AddSql(e.partitionBy)
+	}
+	if len(e.primaryKey) > 0 {
+		e.self.AddSql(" PRIMARY KEY ")
+		e.self.AddSql(keyToString(e.primaryKey))
+	}
+	if e.sampleBy != "" {
+		e.self.AddSql(" SAMPLE BY ")
+		e.self.AddSql(e.sampleBy)
+	}
+	if len(e.ttl) > 0 {
+		e.self.AddSql(" TTL ")
+		e.self.AddSql(strings.Join(e.ttl, ", "))
+	}
+	return e.self
+}
+
+func keyToString(columns []string) string {
+	switch len(columns) {
+	case 0:
+		return "tuple()"
+	case 1:
+		return columns[0]
+	default:
+		return "(" + strings.Join(columns, ", ") + ")"
+	}
+}
@@ -7,14 +7,45 @@ import (
 
 type FromClause[T sqb.Statement[T]] struct {
 	*sql.FromClause[T]
+	self        T
+	infile      string
+	compression string
 }
 
 func NewFromClause[T sqb.Statement[T]](self T) *FromClause[T] {
-	return &FromClause[T]{sql.NewFromClause[T](self)}
+	return &FromClause[T]{sql.NewFromClause[T](self), self, "", ""}
 }
-func (j *FromClause[T]) FromInfile(table any) T {
-	return j.From("INFILE", table)
+
+// FromInfile reads the inserted data from the client side file with the optional compression type,
+// e.g. FromInfile("data.csv.gz", "gzip") is FROM INFILE 'data.csv.gz' COMPRESSION 'gzip'.
+func (f *FromClause[T]) FromInfile(path string, compression ...string) T {
+	f.infile = path
+	f.compression = ""
+	if len(compression) > 0 {
+		f.compression = compression[0]
+	}
+	f.self.Dirty()
+	return f.self
+}
+
+func (f *FromClause[T]) CleanFrom() T {
+	f.infile = ""
+	f.compression = ""
+	return f.FromClause.CleanFrom()
 }
-func (j *FromClause[T]) CopyFrom(self T) *FromClause[T] {
-	return &FromClause[T]{j.FromClause.CopyFrom(self)}
+
+func (f *FromClause[T]) CopyFrom(self T) *FromClause[T] {
+	return &FromClause[T]{f.FromClause.CopyFrom(self), self, f.infile, f.compression}
+}
+
+func (f *FromClause[T]) BuildInfile() T {
+	if f.infile != "" {
+		f.self.AddSql(" FROM INFILE ")
+		f.self.AddSql(quoteString(f.infile))
+		if f.compression != "" {
+			f.self.AddSql(" COMPRESSION ")
+			f.self.AddSql(quoteString(f.compression))
+		}
+	}
+	return f.self
 }
@@ -0,0 +1,61 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/clause"
+)
+
+type GroupClause[T sqb.Statement[T]] struct {
+	*sql.GroupClause[T]
+	self     T
+	modifier string
+	totals   bool
+}
+
+func NewGroupClause[T sqb.Statement[T]](self T) *GroupClause[T] {
+	return &GroupClause[T]{sql.NewGroupClause[T](self), self, "", false}
+}
+
+// WithRollup adds the subtotals of the grouping keys from right to left.
+func (g *GroupClause[T]) WithRollup() T {
+	g.modifier = " WITH ROLLUP"
+	g.self.Dirty()
+	return g.self
+}
+
+// WithCube adds the subtotals of all combinations of the grouping keys.
+func (g *GroupClause[T]) WithCube() T {
+	g.modifier = " WITH CUBE"
+	g.self.Dirty()
+	return g.self
+}
+
+// WithTotals adds the row of the totals calculated across all rows.
+func (g *GroupClause[T]) WithTotals() T {
+	g.totals = true
+	g.self.Dirty()
+	return g.self
+}
+
+func (g *GroupClause[T]) CleanGroup() T {
+	g.modifier = ""
+	g.totals = false
+	return g.GroupClause.CleanGroup()
+}
+
+func (g *GroupClause[T]) CopyGroup(self T) *GroupClause[T] {
+	return &GroupClause[T]{g.GroupClause.CopyGroup(self), self, g.modifier, g.totals}
+}
+
+// BuildGroup adds the GROUP BY clause, the modifiers are skipped if there are no grouping columns.
+func (g *GroupClause[T]) BuildGroup() T {
+	if !g.HasGroup() {
+		return g.self
+	}
+	g.GroupClause.BuildGroup()
+	g.self.AddSql(g.modifier)
+	if g.totals {
+		g.self.AddSql(" WITH TOTALS")
+	}
+	return g.self
+}
@@ -0,0 +1,57 @@
+package clickhouse
+
+import (
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+type InPartitionClause[T sqb.Statement[T]] struct {
+	self T
+	exp  sql.Expression
+}
+
+func NewInPartitionClause[T sqb.Statement[T]](self T) *InPartitionClause[T] {
+	return &InPartitionClause[T]{self, sql.EmptyExp()}
+}
+
+// InPartition restricts the statement to the partition given by the partition expression,
+// e.g. InPartition("202401") or InPartition("tuple('2024-01-01', 1)").
+func (p *InPartitionClause[T]) InPartition(partition any) T {
+	p.exp.Clean()
+	p.exp.AddSql(" IN PARTITION ")
+	list := sql.NewColumnListExp(partition)
+	p.exp.AddParams(list.Params())
+	p.exp.AddSql(list.String())
+	p.self.Dirty()
+	return p.self
+}
+
+// InPartitionId restricts the statement to the partition with the given identifier from system.parts.
+func (p *InPartitionClause[T]) InPartitionId(id string) T {
+	p.exp.Clean()
+	p.exp.AddSql(" IN PARTITION ID '")
+	p.exp.AddSql(strings.ReplaceAll(id, "'", "''"))
+	p.exp.AddSql("'")
+	p.self.Dirty()
+	return p.self
+}
+
+func (p *InPartitionClause[T]) CleanInPartition() T {
+	p.exp.Clean()
+	p.self.Dirty()
+	return p.self
+}
+
+func (p *InPartitionClause[T]) CopyInPartition(self T) *InPartitionClause[T] {
+	return &InPartitionClause[T]{self, p.exp.Copy()}
+}
+
+func (p *InPartitionClause[T]) BuildInPartition() T {
+	if p.exp.IsNotEmpty() {
+		p.self.AddParams(p.exp.Params())
+		p.self.AddSql(p.exp.String())
+	}
+	return p.self
+}
@@ -0,0 +1,65 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// InterpolateClause is the INTERPOLATE clause filling the columns of the rows added by ORDER BY ... WITH FILL.
+type InterpolateClause[T sqb.Statement[T]] struct {
+	self T
+	exp  sql.Expression
+	used bool
+}
+
+func NewInterpolateClause[T sqb.Statement[T]](self T) *InterpolateClause[T] {
+	return &InterpolateClause[T]{self, sql.EmptyExp(), false}
+}
+
+// Interpolate adds the column and the expression of its value in the filled rows:
+//   - Interpolate() fills all columns with their previous values
+//   - Interpolate(column string) fills the column with its previous value
+//   - Interpolate(column string, expression any), e.g. Interpolate("v", "v + 1") is INTERPOLATE (v AS v + 1)
+func (i *InterpolateClause[T]) Interpolate(args ...any) T {
+	i.used = true
+	if len(args) > 0 {
+		if i.exp.IsNotEmpty() {
+			i.exp.AddSql(", ")
+		}
+		column := sql.NewColumnListExp(args[0])
+		i.exp.AddParams(column.Params())
+		i.exp.AddSql(column.String())
+		if len(args) > 1 {
+			value := sql.NewColumnListExp(args[1])
+			i.exp.AddParams(value.Params())
+			i.exp.AddSql(" AS ")
+			i.exp.AddSql(value.String())
+		}
+	}
+	i.self.Dirty()
+	return i.self
+}
+
+func (i *InterpolateClause[T]) CleanInterpolate() T {
+	i.exp.Clean()
+	i.used = false
+	i.self.Dirty()
+	return i.self
+}
+
+func (i *InterpolateClause[T]) CopyInterpolate(self T) *InterpolateClause[T] {
+	return &InterpolateClause[T]{self, i.exp.Copy(), i.used}
+}
+
+func (i *InterpolateClause[T]) BuildInterpolate() T {
+	if i.used {
+		i.self.AddSql(" INTERPOLATE")
+		if i.exp.IsNotEmpty() {
+			i.self.AddParams(i.exp.Params())
+			i.self.AddSql(" (")
+			i.self.AddSql(i.exp.String())
+			i.self.AddSql(")")
+		}
+	}
+	return i.self
+}
@@ -1,10 +1,105 @@
 package clickhouse
 
 import (
+	"strings"
+
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/clause"
 )
 
+// JoinKind is the composable ClickHouse join type [GLOBAL|LOCAL] [INNER|LEFT|RIGHT|FULL|CROSS|PASTE]
+// [OUTER|ALL|ANY|SEMI|ANTI|ASOF] JOIN, e.g. NewJoinKind().Global().Left().Any() is GLOBAL LEFT ANY JOIN.
+type JoinKind struct {
+	locality   string
+	joinType   string
+	strictness string
+}
+
+func NewJoinKind() JoinKind {
+	return JoinKind{}
+}
+
+// Global sends the right table to all remote servers of the distributed query.
+func (k JoinKind) Global() JoinKind {
+	k.locality = "GLOBAL"
+	return k
+}
+
+func (k JoinKind) Local() JoinKind {
+	k.locality = "LOCAL"
+	return k
+}
+
+func (k JoinKind) Inner() JoinKind {
+	k.joinType = "INNER"
+	return k
+}
+
+func (k JoinKind) Left() JoinKind {
+	k.joinType = "LEFT"
+	return k
+}
+
+func (k JoinKind) Right() JoinKind {
+	k.joinType = "RIGHT"
+	return k
+}
+
+func (k JoinKind) Full() JoinKind {
+	k.joinType = "FULL"
+	return k
+}
+
+func (k JoinKind) Cross() JoinKind {
+	k.joinType = "CROSS"
+	return k
+}
+
+func (k JoinKind) Paste() JoinKind {
+	k.joinType = "PASTE"
+	return k
+}
+
+func (k JoinKind) Outer() JoinKind {
+	k.strictness = "OUTER"
+	return k
+}
+
+func (k JoinKind) All() JoinKind {
+	k.strictness = "ALL"
+	return k
+}
+
+func (k JoinKind) Any() JoinKind {
+	k.strictness = "ANY"
+	return k
+}
+
+func (k JoinKind) Semi() JoinKind {
+	k.strictness = "SEMI"
+	return k
+}
+
+func (k JoinKind) Anti() JoinKind {
+	k.strictness = "ANTI"
+	return k
+}
+
+func (k JoinKind) Asof() JoinKind {
+	k.strictness = "ASOF"
+	return k
+}
+
+func (k JoinKind) String() string {
+	parts := make([]string, 0, 4)
+	for _, part := range []string{k.locality, k.joinType, k.strictness} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(append(parts, "JOIN"), " ")
+}
+
 type JoinClause[T sqb.Statement[T]] struct {
 	*sql.JoinClause[T]
 }
@@ -12,43 +107,66 @@ type JoinClause[T sqb.Statement[T]] struct {
 func NewJoinClause[T sqb.Statement[T]](self T) *JoinClause[T] {
 	return &JoinClause[T]{sql.NewJoinClause[T](self)}
 }
+
+// JoinOf joins new table with the composed join kind:
+//   - JoinOf(kind JoinKind, table any, condition any)
+//   - JoinOf(kind JoinKind, table any, alias any, condition any)
+func (j *JoinClause[T]) JoinOf(kind JoinKind, table any, args ...any) T {
+	return j.Join(kind.String(), table, args...)
+}
+
+func (j *JoinClause[T]) GlobalInnerJoin(table any, args ...any) T {
+	return j.JoinOf(NewJoinKind().Global().Inner(), table, args...)
+}
+
+func (j *JoinClause[T]) GlobalLeftJoin(table any, args ...any) T {
+	return j.JoinOf(NewJoinKind().Global().Left(), table, args...)
+}
+
 func (j *JoinClause[T]) LeftSemiJoin(table any, args ...any) T {
-	return j.Join("LEFT SEMI JOIN", table, args...)
+	return j.JoinOf(NewJoinKind().Left().Semi(), table, args...)
 }
+
 func (j *JoinClause[T]) RightSemiJoin(table any, args ...any) T {
-	return j.Join("RIGHT SEMI JOIN", table, args...)
+	return j.JoinOf(NewJoinKind().Right().Semi(), table, args...)
 }
 
 func (j *JoinClause[T]) ArrayJoin(array any, args ...any) T {
 	return j.Join("ARRAY JOIN", array, args...)
 }
+
 func (j *JoinClause[T]) LeftAntiJoin(array any, args ...any) T {
-	return j.Join("LEFT ANTI JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Left().Anti(), array, args...)
 }
 
 func (j *JoinClause[T]) RightAntiJoin(array any, args ...any) T {
-	return j.Join("RIGHT ANTI JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Right().Anti(), array, args...)
 }
+
 func (j *JoinClause[T]) LeftAnyJoin(array any, args ...any) T {
-	return j.Join("LEFT ANY JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Left().Any(), array, args...)
 }
+
 func (j *JoinClause[T]) RightAnyJoin(array any, args ...any) T {
-	return j.Join("RIGHT ANY JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Right().Any(), array, args...)
 }
+
 func (j *JoinClause[T]) InnerAnyJoin(array any, args ...any) T {
-	return j.Join("INNER ANY JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Inner().Any(), array, args...)
 }
 
 func (j *JoinClause[T]) AsofJoin(array any, args ...any) T {
-	return j.Join("ASOF JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Asof(), array, args...)
 }
 
 func (j *JoinClause[T]) LeftAsofJoin(array any, args ...any) T {
-	return j.Join("LEFT ASOF JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Left().Asof(), array, args...)
 }
+
 func (j *JoinClause[T]) PasteJoin(array any, args ...any) T {
-	return j.Join("PASTE JOIN", array, args...)
+	return j.JoinOf(NewJoinKind().Paste(), array, args...)
 }
+
 func (j *JoinClause[T]) CopyJoin(self T) *JoinClause[T] {
 	return &JoinClause[T]{j.JoinClause.CopyJoin(self)}
 }
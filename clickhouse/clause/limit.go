@@ -0,0 +1,50 @@
+package clickhouse
+
+import (
+	"errors"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/clause"
+)
+
+type LimitClause[T sqb.Statement[T]] struct {
+	*sql.LimitClause[T]
+	withTies bool
+	self     T
+}
+
+func NewLimitClause[T sqb.Statement[T]](self T) *LimitClause[T] {
+	return &LimitClause[T]{sql.NewLimitClause[T](self), false, self}
+}
+
+// WithTies returns the rows having the same ORDER BY values as the last row of the limited result.
+func (l *LimitClause[T]) WithTies() T {
+	l.withTies = true
+	l.self.Dirty()
+	return l.self
+}
+
+func (l *LimitClause[T]) CleanLimit() T {
+	l.withTies = false
+	return l.LimitClause.CleanLimit()
+}
+
+func (l *LimitClause[T]) CopyLimit(self T) *LimitClause[T] {
+	return &LimitClause[T]{l.LimitClause.CopyLimit(self), l.withTies, self}
+}
+
+// ValidateWithTies returns the error if WITH TIES is used without ORDER BY.
+func (l *LimitClause[T]) ValidateWithTies(hasOrder bool) error {
+	if l.withTies && l.HasLimit() && !hasOrder {
+		return errors.New("LIMIT WITH TIES requires ORDER BY")
+	}
+	return nil
+}
+
+// BuildWithTies adds WITH TIES after LIMIT and OFFSET if the limit is set.
+func (l *LimitClause[T]) BuildWithTies() T {
+	if l.withTies && l.HasLimit() {
+		l.self.AddSql(" WITH TIES")
+	}
+	return l.self
+}
@@ -0,0 +1,63 @@
+package clickhouse
+
+import (
+	"strconv"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// LimitByClause is the LIMIT n [OFFSET m] BY clause returning the first rows of every group of the given columns.
+type LimitByClause[T sqb.Statement[T]] struct {
+	self   T
+	limit  int
+	offset int
+	exp    sql.ColumnListExpression
+}
+
+func NewLimitByClause[T sqb.Statement[T]](self T) *LimitByClause[T] {
+	return &LimitByClause[T]{self, -1, -1, sql.EmptyColumnListExp()}
+}
+
+// LimitBy returns the first rows of every group of the columns or expressions, e.g. LimitBy(2, "domain", "device").
+func (l *LimitByClause[T]) LimitBy(limit int, columns ...any) T {
+	l.limit = limit
+	l.exp.Clean()
+	for _, column := range columns {
+		l.exp.Append(column)
+	}
+	l.self.Dirty()
+	return l.self
+}
+
+// LimitByOffset skips the first rows of every group of the LIMIT BY clause.
+func (l *LimitByClause[T]) LimitByOffset(offset int) T {
+	l.offset = offset
+	l.self.Dirty()
+	return l.self
+}
+
+func (l *LimitByClause[T]) CleanLimitBy() T {
+	l.limit = -1
+	l.offset = -1
+	l.exp.Clean()
+	l.self.Dirty()
+	return l.self
+}
+
+func (l *LimitByClause[T]) CopyLimitBy(self T) *LimitByClause[T] {
+	return &LimitByClause[T]{self, l.limit, l.offset, l.exp.Copy()}
+}
+
+func (l *LimitByClause[T]) BuildLimitBy() T {
+	if l.limit >= 0 && l.exp.IsNotEmpty() {
+		l.self.AddSql(" LIMIT " + strconv.Itoa(l.limit))
+		if l.offset >= 0 {
+			l.self.AddSql(" OFFSET " + strconv.Itoa(l.offset))
+		}
+		l.self.AddParams(l.exp.Params())
+		l.self.AddSql(" BY ")
+		l.self.AddSql(l.exp.String())
+	}
+	return l.self
+}
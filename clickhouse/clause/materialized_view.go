@@ -0,0 +1,97 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+type CreateMaterializedViewClause[T sqb.Statement[T]] struct {
+	self        T
+	exp         sql.DirectListExpression
+	to          sql.DirectListExpression
+	ifNotExists bool
+	populate    bool
+}
+
+func NewCreateMaterializedViewClause[T sqb.Statement[T]](self T) *CreateMaterializedViewClause[T] {
+	return &CreateMaterializedViewClause[T]{self, sql.EmptyDirectListExp(), sql.EmptyDirectListExp(), false, false}
+}
+
+func (c *CreateMaterializedViewClause[T]) View(view any) T {
+	c.exp.Clean()
+	c.exp.Append(view)
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateMaterializedViewClause[T]) IfNotExists() T {
+	c.ifNotExists = true
+	c.self.Dirty()
+	return c.self
+}
+
+// To sets the target table the view writes the query result to instead of its own inner table.
+// The view with the target table cannot have the engine and cannot be populated.
+func (c *CreateMaterializedViewClause[T]) To(table any) T {
+	c.to.Clean()
+	c.to.Append(table)
+	c.self.Dirty()
+	return c.self
+}
+
+// Populate fills the view with the data existing in the source table on creation.
+func (c *CreateMaterializedViewClause[T]) Populate() T {
+	c.populate = true
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateMaterializedViewClause[T]) HasTo() bool {
+	return c.to.IsNotEmpty()
+}
+
+func (c *CreateMaterializedViewClause[T]) IsPopulate() bool {
+	return c.populate
+}
+
+func (c *CreateMaterializedViewClause[T]) CleanCreateMaterializedView() T {
+	c.exp.Clean()
+	c.to.Clean()
+	c.ifNotExists = false
+	c.populate = false
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateMaterializedViewClause[T]) CopyCreateMaterializedView(self T) *CreateMaterializedViewClause[T] {
+	return &CreateMaterializedViewClause[T]{self, c.exp.Copy(), c.to.Copy(), c.ifNotExists, c.populate}
+}
+
+func (c *CreateMaterializedViewClause[T]) BuildCreateMaterializedView() T {
+	c.self.AddSql("CREATE MATERIALIZED VIEW")
+	if c.ifNotExists {
+		c.self.AddSql(" IF NOT EXISTS")
+	}
+	if c.exp.IsNotEmpty() {
+		c.self.AddParams(c.exp.Params())
+		c.self.AddSql(" ")
+		c.self.AddSql(c.exp.String())
+	}
+	return c.self
+}
+
+func (c *CreateMaterializedViewClause[T]) BuildTo() T {
+	if c.to.IsNotEmpty() {
+		c.self.AddParams(c.to.Params())
+		c.self.AddSql(" TO ")
+		c.self.AddSql(c.to.String())
+	}
+	return c.self
+}
+
+func (c *CreateMaterializedViewClause[T]) BuildPopulate() T {
+	if c.populate {
+		c.self.AddSql(" POPULATE")
+	}
+	return c.self
+}
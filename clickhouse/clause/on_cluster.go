@@ -0,0 +1,37 @@
+package clickhouse
+
+import "github.com/AlephTav/sqb"
+
+type OnClusterClause[T sqb.Statement[T]] struct {
+	self    T
+	cluster string
+}
+
+func NewOnClusterClause[T sqb.Statement[T]](self T) *OnClusterClause[T] {
+	return &OnClusterClause[T]{self, ""}
+}
+
+// OnCluster runs the statement on every host of the cluster.
+func (o *OnClusterClause[T]) OnCluster(cluster string) T {
+	o.cluster = cluster
+	o.self.Dirty()
+	return o.self
+}
+
+func (o *OnClusterClause[T]) CleanOnCluster() T {
+	o.cluster = ""
+	o.self.Dirty()
+	return o.self
+}
+
+func (o *OnClusterClause[T]) CopyOnCluster(self T) *OnClusterClause[T] {
+	return &OnClusterClause[T]{self, o.cluster}
+}
+
+func (o *OnClusterClause[T]) BuildOnCluster() T {
+	if o.cluster != "" {
+		o.self.AddSql(" ON CLUSTER ")
+		o.self.AddSql(o.cluster)
+	}
+	return o.self
+}
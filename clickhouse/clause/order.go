@@ -0,0 +1,123 @@
+package clickhouse
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+// FillOptions are the FROM, TO, STEP and STALENESS options of the ORDER BY ... WITH FILL modifier.
+// The values are bound as parameters except expressions and durations, a duration is written as INTERVAL.
+type FillOptions struct {
+	from      any
+	to        any
+	step      any
+	staleness any
+}
+
+func Fill() *FillOptions {
+	return &FillOptions{}
+}
+
+func (f *FillOptions) From(value any) *FillOptions {
+	f.from = value
+	return f
+}
+
+func (f *FillOptions) To(value any) *FillOptions {
+	f.to = value
+	return f
+}
+
+// Step sets the increment of the filled values, e.g. Step(1), Step(time.Hour) or Step(exp.NewExp("INTERVAL 1 MONTH")).
+func (f *FillOptions) Step(value any) *FillOptions {
+	f.step = value
+	return f
+}
+
+// Staleness sets the maximum distance from the original row of the filled rows.
+func (f *FillOptions) Staleness(value any) *FillOptions {
+	f.staleness = value
+	return f
+}
+
+type OrderClause[T sqb.Statement[T]] struct {
+	*sql.OrderClause[T]
+}
+
+func NewOrderClause[T sqb.Statement[T]](self T) *OrderClause[T] {
+	return &OrderClause[T]{sql.NewOrderClause[T](self)}
+}
+
+// OrderByWithFill adds column and its order with the WITH FILL modifier to the order clause:
+//   - OrderByWithFill(column any, fill *FillOptions)
+//   - OrderByWithFill(column any, fill *FillOptions, order any)
+func (o *OrderClause[T]) OrderByWithFill(column any, fill *FillOptions, args ...any) T {
+	item := exp.EmptyExp()
+	columns := exp.NewColumnListExp(column)
+	item.AddParams(columns.Params())
+	item.AddSql(columns.String())
+	if len(args) > 0 && args[0] != nil {
+		item.AddSql(" ")
+		item.AddSql(exp.NewColumnListExp(args[0]).String())
+	}
+	item.AddSql(" WITH FILL")
+	if fill != nil {
+		addFillOption(item, "FROM", fill.from)
+		addFillOption(item, "TO", fill.to)
+		addFillOption(item, "STEP", fill.step)
+		addFillOption(item, "STALENESS", fill.staleness)
+	}
+	return o.OrderBy(item)
+}
+
+func (o *OrderClause[T]) CopyOrder(self T) *OrderClause[T] {
+	return &OrderClause[T]{o.OrderClause.CopyOrder(self)}
+}
+
+func addFillOption(item exp.Expression, option string, value any) {
+	if value == nil {
+		return
+	}
+	item.AddSql(" ")
+	item.AddSql(option)
+	item.AddSql(" ")
+	switch value.(type) {
+	case exp.Expression:
+		item.AddParams(value.(exp.Expression).Params())
+		item.AddSql(value.(exp.Expression).String())
+	case time.Duration:
+		item.AddSql(intervalToString(value.(time.Duration)))
+	default:
+		param := sqb.NextParameterName()
+		item.AddParams(map[string]any{param: value})
+		if placeholder, ok := value.(exp.ParameterPlaceholder); ok {
+			item.AddSql(placeholder.Placeholder(param))
+		} else {
+			item.AddSql(":" + param)
+		}
+	}
+}
+
+func intervalToString(duration time.Duration) string {
+	units := []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"DAY", 24 * time.Hour},
+		{"HOUR", time.Hour},
+		{"MINUTE", time.Minute},
+		{"SECOND", time.Second},
+		{"MILLISECOND", time.Millisecond},
+		{"MICROSECOND", time.Microsecond},
+	}
+	for _, unit := range units {
+		if duration%unit.duration == 0 {
+			return "INTERVAL " + strconv.FormatInt(int64(duration/unit.duration), 10) + " " + unit.name
+		}
+	}
+	return "INTERVAL " + strconv.FormatInt(int64(duration), 10) + " NANOSECOND"
+}
@@ -0,0 +1,84 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// ProjectionClause is the list of the projection actions of the ALTER TABLE statement.
+type ProjectionClause[T sqb.Statement[T]] struct {
+	self T
+	exp  sql.Expression
+}
+
+func NewProjectionClause[T sqb.Statement[T]](self T) *ProjectionClause[T] {
+	return &ProjectionClause[T]{self, sql.EmptyExp()}
+}
+
+// AddProjection adds the projection storing the query result in the table parts, the query has no FROM clause:
+//   - AddProjection(name string, query sqb.Query)
+//   - AddProjection(name string, query sqb.Query, ifNotExists bool)
+func (p *ProjectionClause[T]) AddProjection(name string, query sqb.Query, args ...bool) T {
+	action := "ADD PROJECTION "
+	if len(args) > 0 && args[0] {
+		action += "IF NOT EXISTS "
+	}
+	p.exp.AddParams(query.Params())
+	return p.add(action + name + " (" + query.String() + ")")
+}
+
+// MaterializeProjection builds the projection for the existing data parts:
+//   - MaterializeProjection(name string)
+//   - MaterializeProjection(name string, ifExists bool)
+func (p *ProjectionClause[T]) MaterializeProjection(name string, args ...bool) T {
+	return p.add("MATERIALIZE PROJECTION " + ifExists(args) + name)
+}
+
+// ClearProjection deletes the projection files from the disk keeping its description:
+//   - ClearProjection(name string)
+//   - ClearProjection(name string, ifExists bool)
+func (p *ProjectionClause[T]) ClearProjection(name string, args ...bool) T {
+	return p.add("CLEAR PROJECTION " + ifExists(args) + name)
+}
+
+// DropProjection removes the projection description and its files:
+//   - DropProjection(name string)
+//   - DropProjection(name string, ifExists bool)
+func (p *ProjectionClause[T]) DropProjection(name string, args ...bool) T {
+	return p.add("DROP PROJECTION " + ifExists(args) + name)
+}
+
+func (p *ProjectionClause[T]) add(action string) T {
+	if p.exp.IsNotEmpty() {
+		p.exp.AddSql(", ")
+	}
+	p.exp.AddSql(action)
+	p.self.Dirty()
+	return p.self
+}
+
+func (p *ProjectionClause[T]) CleanProjection() T {
+	p.exp.Clean()
+	p.self.Dirty()
+	return p.self
+}
+
+func (p *ProjectionClause[T]) CopyProjection(self T) *ProjectionClause[T] {
+	return &ProjectionClause[T]{self, p.exp.Copy()}
+}
+
+func (p *ProjectionClause[T]) BuildProjection() T {
+	if p.exp.IsNotEmpty() {
+		p.self.AddParams(p.exp.Params())
+		p.self.AddSql(" ")
+		p.self.AddSql(p.exp.String())
+	}
+	return p.self
+}
+
+func ifExists(args []bool) string {
+	if len(args) > 0 && args[0] {
+		return "IF EXISTS "
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package clickhouse
+
+import (
+	"strings"
+
+	"github.com/AlephTav/sqb"
+)
+
+// RefreshClause is the schedule of the refreshable materialized view.
+type RefreshClause[T sqb.Statement[T]] struct {
+	self      T
+	refresh   string
+	offset    string
+	randomize string
+	dependsOn []string
+	append    bool
+}
+
+func NewRefreshClause[T sqb.Statement[T]](self T) *RefreshClause[T] {
+	return &RefreshClause[T]{self: self}
+}
+
+// RefreshEvery refreshes the view at the fixed interval aligned to the interval start, e.g. RefreshEvery("1 HOUR").
+func (r *RefreshClause[T]) RefreshEvery(interval string) T {
+	r.refresh = "EVERY " + interval
+	r.self.Dirty()
+	return r.self
+}
+
+// RefreshAfter refreshes the view after the interval has passed since the previous refresh.
+func (r *RefreshClause[T]) RefreshAfter(interval string) T {
+	r.refresh = "AFTER " + interval
+	r.self.Dirty()
+	return r.self
+}
+
+// RefreshOffset shifts the refresh time of RefreshEvery, e.g. RefreshOffset("5 MINUTE").
+func (r *RefreshClause[T]) RefreshOffset(interval string) T {
+	r.offset = interval
+	r.self.Dirty()
+	return r.self
+}
+
+// RandomizeFor adds the random delay up to the interval to every refresh.
+func (r *RefreshClause[T]) RandomizeFor(interval string) T {
+	r.randomize = interval
+	r.self.Dirty()
+	return r.self
+}
+
+// DependsOn makes the view refresh after the given refreshable views.
+func (r *RefreshClause[T]) DependsOn(views ...string) T {
+	r.dependsOn = append(r.dependsOn, views...)
+	r.self.Dirty()
+	return r.self
+}
+
+// Append makes the refresh append the rows to the target table instead of replacing them.
+func (r *RefreshClause[T]) Append() T {
+	r.append = true
+	r.self.Dirty()
+	return r.self
+}
+
+func (r *RefreshClause[T]) CleanRefresh() T {
+	r.refresh = ""
+	r.offset = ""
+	r.randomize = ""
+	r.dependsOn = nil
+	r.append = false
+	r.self.Dirty()
+	return r.self
+}
+
+func (r *RefreshClause[T]) CopyRefresh(self T) *RefreshClause[T] {
+	return &RefreshClause[T]{self, r.refresh, r.offset, r.randomize, append([]string(nil), r.dependsOn...), r.append}
+}
+
+func (r *RefreshClause[T]) BuildRefresh() T {
+	if r.refresh == "" {
+		return r.self
+	}
+	r.self.AddSql(" REFRESH ")
+	r.self.AddSql(r.refresh)
+	if r.offset != "" {
+		r.self.AddSql(" OFFSET ")
+		r.self.AddSql(r.offset)
+	}
+	if r.randomize != "" {
+		r.self.AddSql(" RANDOMIZE FOR ")
+		r.self.AddSql(r.randomize)
+	}
+	if len(r.dependsOn) > 0 {
+		r.self.AddSql(" DEPENDS ON ")
+		r.self.AddSql(strings.Join(r.dependsOn, ", "))
+	}
+	if r.append {
+		r.self.AddSql(" APPEND")
+	}
+	return r.self
+}
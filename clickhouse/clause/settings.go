@@ -1,17 +1,219 @@
 package clickhouse
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/expression"
 )
 
+// Setting is the ClickHouse setting with the value rendered as the SQL literal.
+type Setting struct {
+	name  string
+	value string
+}
+
+type settingKind string
+
+const (
+	boolSetting    settingKind = "boolean"
+	uintSetting    settingKind = "non-negative integer"
+	secondsSetting settingKind = "non-negative duration"
+	stringSetting  settingKind = "string"
+	levelSetting   settingKind = "integer from 0 to 2"
+)
+
+var settingKinds = map[string]settingKind{
+	"async_insert":               boolSetting,
+	"wait_for_async_insert":      boolSetting,
+	"max_threads":                uintSetting,
+	"max_execution_time":         secondsSetting,
+	"max_memory_usage":           uintSetting,
+	"join_use_nulls":             boolSetting,
+	"insert_deduplication_token": stringSetting,
+	"final":                      boolSetting,
+	"mutations_sync":             levelSetting,
+}
+
+// NewSetting creates the setting validating the value type of the known settings, the value is a boolean,
+// a number, a string or time.Duration written in seconds:
+//   - NewSetting("max_threads", 8) is max_threads = 8
+//   - NewSetting("async_insert", true) is async_insert = 1
+//   - NewSetting("max_execution_time", 90*time.Second) is max_execution_time = 90
+//   - NewSetting("insert_deduplication_token", "batch-1") is insert_deduplication_token = 'batch-1'
+func NewSetting(name string, value any) (Setting, error) {
+	if kind, ok := settingKinds[name]; ok && !kind.accepts(value) {
+		return Setting{}, fmt.Errorf("setting %s expects %s value, %T given", name, kind, value)
+	}
+	literal, err := settingLiteral(value)
+	if err != nil {
+		return Setting{}, fmt.Errorf("setting %s: %w", name, err)
+	}
+	return Setting{name, literal}, nil
+}
+
+func mustSetting(name string, value any) Setting {
+	setting, err := NewSetting(name, value)
+	if err != nil {
+		panic(err)
+	}
+	return setting
+}
+
+// AsyncInsert enables the asynchronous inserts buffered on the server.
+func AsyncInsert(enabled bool) Setting {
+	return mustSetting("async_insert", enabled)
+}
+
+// WaitForAsyncInsert makes the asynchronous insert wait for the buffer flush.
+func WaitForAsyncInsert(enabled bool) Setting {
+	return mustSetting("wait_for_async_insert", enabled)
+}
+
+func MaxThreads(threads uint) Setting {
+	return mustSetting("max_threads", threads)
+}
+
+// MaxExecutionTime limits the query execution time, the duration is written in seconds, the negative duration is zero.
+func MaxExecutionTime(duration time.Duration) Setting {
+	if duration < 0 {
+		duration = 0
+	}
+	return mustSetting("max_execution_time", duration)
+}
+
+// MaxMemoryUsage limits the memory used by the query on a single server in bytes.
+func MaxMemoryUsage(bytes uint64) Setting {
+	return mustSetting("max_memory_usage", bytes)
+}
+
+// JoinUseNulls fills the non-matched rows of outer joins with NULL instead of the default values.
+func JoinUseNulls(enabled bool) Setting {
+	return mustSetting("join_use_nulls", enabled)
+}
+
+// InsertDeduplicationToken sets the token deduplicating the retried inserts.
+func InsertDeduplicationToken(token string) Setting {
+	return mustSetting("insert_deduplication_token", token)
+}
+
+// Final applies the FINAL modifier to all tables of the query.
+func Final(enabled bool) Setting {
+	return mustSetting("final", enabled)
+}
+
+func (s Setting) Name() string {
+	return s.name
+}
+
+func (s Setting) String() string {
+	return s.name + " = " + s.value
+}
+
+func (k settingKind) accepts(value any) bool {
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return false
+	}
+	switch k {
+	case boolSetting:
+		if v.Kind() == reflect.Bool {
+			return true
+		}
+		return isInteger(v) && (integerValue(v) == 0 || integerValue(v) == 1)
+	case uintSetting:
+		return isInteger(v) && integerValue(v) >= 0
+	case levelSetting:
+		if !isInteger(v) {
+			return false
+		}
+		if v.CanInt() {
+			return v.Int() >= 0 && v.Int() <= 2
+		}
+		return v.Uint() <= 2
+	case secondsSetting:
+		if d, ok := value.(time.Duration); ok {
+			return d >= 0
+		}
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return v.Float() >= 0
+		}
+		return isInteger(v) && integerValue(v) >= 0
+	default:
+		return v.Kind() == reflect.String
+	}
+}
+
+func isInteger(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
+}
+
+// integerValue returns the sign of the unsigned integers or the value of the signed ones.
+func integerValue(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > 1 {
+			return 2
+		}
+		return int64(v.Uint())
+	default:
+		return v.Int()
+	}
+}
+
+func settingLiteral(value any) (string, error) {
+	if d, ok := value.(time.Duration); ok {
+		return strconv.FormatFloat(d.Seconds(), 'f', -1, 64), nil
+	}
+	v := reflect.ValueOf(value)
+	if !v.IsValid() {
+		return "", errors.New("value cannot be nil")
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return "1", nil
+		}
+		return "0", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case reflect.String:
+		return quoteString(v.String()), nil
+	default:
+		return "", fmt.Errorf("value of type %T is not supported", value)
+	}
+}
+
+// DefaultSettingsProvider is the optional capability of a StatementExecutor to provide the settings added to
+// the SETTINGS clause of the SELECT and INSERT statements executed by it, the settings of the statement
+// take precedence over them. The defaults are not added to DDL statements, mutations and nested statements.
+type DefaultSettingsProvider interface {
+	DefaultSettings() []Setting
+}
+
 type SettingsClause[T sqb.Statement[T]] struct {
 	self T
 	exp  sql.DirectListExpression
+	err  error
 }
 
 func NewSettingsClause[T sqb.Statement[T]](self T) *SettingsClause[T] {
-	return &SettingsClause[T]{self, sql.EmptyDirectListExp()}
+	return &SettingsClause[T]{self, sql.EmptyDirectListExp(), nil}
 }
 
 func (a *SettingsClause[T]) Settings(expression any, args ...any) T {
@@ -20,22 +222,84 @@ func (a *SettingsClause[T]) Settings(expression any, args ...any) T {
 	return a.self
 }
 
+// Set adds the typed settings to the SETTINGS clause, e.g. Set(AsyncInsert(true), WaitForAsyncInsert(false)).
+func (a *SettingsClause[T]) Set(settings ...Setting) T {
+	for _, setting := range settings {
+		a.exp.Append(setting.String())
+	}
+	a.self.Dirty()
+	return a.self
+}
+
+// MutationsSync sets the mutations_sync setting making the mutation wait for its completion:
+// 0 - asynchronous execution, 1 - wait for the current server, 2 - wait for all replicas.
+// The statement fails if the level is out of this range.
+func (a *SettingsClause[T]) MutationsSync(level int) T {
+	setting, err := NewSetting("mutations_sync", level)
+	if err != nil {
+		if a.err == nil {
+			a.err = err
+		}
+		a.self.Dirty()
+		return a.self
+	}
+	return a.Set(setting)
+}
+
 func (a *SettingsClause[T]) CleanSettings() T {
 	a.exp.Clean()
+	a.err = nil
 	a.self.Dirty()
 	return a.self
 }
 
 func (a *SettingsClause[T]) CopySettings(self T) *SettingsClause[T] {
-	return &SettingsClause[T]{self, a.exp.Copy()}
+	return &SettingsClause[T]{self, a.exp.Copy(), a.err}
 }
 
 func (a *SettingsClause[T]) BuildSettings() T {
-	if a.exp.IsNotEmpty() {
+	return a.buildSettings(nil)
+}
+
+// BuildSettingsWithDefaults builds the SETTINGS clause adding the default settings of the executor
+// which are not set by the statement.
+func (a *SettingsClause[T]) BuildSettingsWithDefaults() T {
+	return a.buildSettings(a.defaultSettings())
+}
+
+func (a *SettingsClause[T]) buildSettings(defaults []string) T {
+	if a.err != nil {
+		sqb.Fail(a.self, a.err)
+	}
+	if a.exp.IsNotEmpty() || len(defaults) > 0 {
 		a.self.AddParams(a.exp.Params())
 		a.self.AddSql(" SETTINGS ")
 		a.self.AddSql(a.exp.String())
-
+		if a.exp.IsNotEmpty() && len(defaults) > 0 {
+			a.self.AddSql(", ")
+		}
+		a.self.AddSql(strings.Join(defaults, ", "))
 	}
 	return a.self
 }
+
+// defaultSettings returns the default settings of the executor which are not set by the statement.
+func (a *SettingsClause[T]) defaultSettings() []string {
+	provider, ok := a.self.Executor().(DefaultSettingsProvider)
+	if !ok {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, item := range sql.SplitList(a.exp.String()) {
+		if name, _, found := strings.Cut(item, "="); found {
+			names[strings.TrimSpace(name)] = true
+		}
+	}
+	var result []string
+	for _, setting := range provider.DefaultSettings() {
+		if !names[setting.Name()] {
+			result = append(result, setting.String())
+		}
+	}
+	return result
+}
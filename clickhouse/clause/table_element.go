@@ -0,0 +1,199 @@
+package clickhouse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// ColumnDef is the column definition of the CREATE TABLE statement.
+// Defaults, materialized and alias expressions are SQL, they are not bound as parameters.
+type ColumnDef struct {
+	name     string
+	dataType string
+	options  []string
+	err      error
+}
+
+func NewColumnDef(name string, dataType string) *ColumnDef {
+	return &ColumnDef{name: name, dataType: dataType}
+}
+
+func (c *ColumnDef) Default(expression any) *ColumnDef {
+	return c.add("DEFAULT " + c.ddl(expression))
+}
+
+// Materialized makes the column computed on insert, it cannot be inserted and is not returned by SELECT *.
+func (c *ColumnDef) Materialized(expression any) *ColumnDef {
+	return c.add("MATERIALIZED " + c.ddl(expression))
+}
+
+// Alias makes the column computed on read, it is not stored in the table.
+func (c *ColumnDef) Alias(expression any) *ColumnDef {
+	return c.add("ALIAS " + c.ddl(expression))
+}
+
+// Ephemeral makes the column used only for the defaults of other columns, it is not stored in the table:
+//   - Ephemeral()
+//   - Ephemeral(expression any)
+func (c *ColumnDef) Ephemeral(args ...any) *ColumnDef {
+	if len(args) > 0 {
+		return c.add("EPHEMERAL " + c.ddl(args[0]))
+	}
+	return c.add("EPHEMERAL")
+}
+
+func (c *ColumnDef) Comment(comment string) *ColumnDef {
+	return c.add("COMMENT " + quoteString(comment))
+}
+
+// Codec sets the compression codecs of the column, e.g. Codec("Delta", "ZSTD(3)").
+func (c *ColumnDef) Codec(codecs ...string) *ColumnDef {
+	return c.add("CODEC(" + strings.Join(codecs, ", ") + ")")
+}
+
+// TTL sets the expiration of the column values, e.g. TTL("d + INTERVAL 1 MONTH").
+func (c *ColumnDef) TTL(expression any) *ColumnDef {
+	return c.add("TTL " + c.ddl(expression))
+}
+
+func (c *ColumnDef) add(option string) *ColumnDef {
+	c.options = append(c.options, option)
+	return c
+}
+
+func (c *ColumnDef) ddl(value any) string {
+	ddl, err := sql.DdlToString(value)
+	if c.err == nil {
+		c.err = err
+	}
+	return ddl
+}
+
+func (c *ColumnDef) String() string {
+	var result strings.Builder
+	result.WriteString(c.name)
+	if c.dataType != "" {
+		result.WriteByte(' ')
+		result.WriteString(c.dataType)
+	}
+	for _, option := range c.options {
+		result.WriteByte(' ')
+		result.WriteString(option)
+	}
+	return result.String()
+}
+
+func quoteString(value string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), "'", `\'`) + "'"
+}
+
+func projectionToString(query any) (string, error) {
+	if q, ok := query.(sqb.Query); ok {
+		if len(q.Params()) > 0 {
+			return q.String(), fmt.Errorf("projection query %q cannot have parameters, use SQL literals instead", q.String())
+		}
+		return q.String(), nil
+	}
+	return sql.DdlToString(query)
+}
+
+// TableElementsClause is the list of columns, indexes and constraints of the CREATE TABLE statement.
+type TableElementsClause[T sqb.Statement[T]] struct {
+	self     T
+	elements []string
+	err      error
+}
+
+func NewTableElementsClause[T sqb.Statement[T]](self T) *TableElementsClause[T] {
+	return &TableElementsClause[T]{self, nil, nil}
+}
+
+// Column adds the column definition to the table:
+//   - Column(column *ColumnDef)
+//   - Column(name string, dataType string, options ...string)
+func (e *TableElementsClause[T]) Column(column any, args ...any) T {
+	if def, ok := column.(*ColumnDef); ok {
+		e.add(def.String(), def.err)
+		return e.self
+	}
+	var result error
+	items := make([]string, 0, len(args)+1)
+	for _, item := range append([]any{column}, args...) {
+		ddl, err := sql.DdlToString(item)
+		if result == nil {
+			result = err
+		}
+		items = append(items, ddl)
+	}
+	e.add(strings.Join(items, " "), result)
+	return e.self
+}
+
+// Index adds the data skipping index, e.g. Index("idx_url", "url", "bloom_filter(0.01)", 4)
+// is INDEX idx_url url TYPE bloom_filter(0.01) GRANULARITY 4.
+func (e *TableElementsClause[T]) Index(name string, expression any, indexType string, granularity int) T {
+	ddl, err := sql.DdlToString(expression)
+	e.add("INDEX "+name+" "+ddl+" TYPE "+indexType+" GRANULARITY "+strconv.Itoa(granularity), err)
+	return e.self
+}
+
+// Projection adds the projection storing the query result in the table parts, the query has no FROM clause,
+// e.g. Projection("p_sum", "SELECT a, sum(b) GROUP BY a") is PROJECTION p_sum (SELECT a, sum(b) GROUP BY a).
+// The query cannot have parameters since DDL statements are not bound.
+func (e *TableElementsClause[T]) Projection(name string, query any) T {
+	ddl, err := projectionToString(query)
+	e.add("PROJECTION "+name+" ("+ddl+")", err)
+	return e.self
+}
+
+// Constraint adds the CHECK or ASSUME constraint:
+//   - Constraint(name string, condition any) adds CONSTRAINT name CHECK condition
+//   - Constraint(name string, condition any, kind string), e.g. kind is "ASSUME"
+func (e *TableElementsClause[T]) Constraint(name string, condition any, args ...string) T {
+	kind := "CHECK"
+	if len(args) > 0 && args[0] != "" {
+		kind = args[0]
+	}
+	ddl, err := sql.DdlToString(condition)
+	e.add("CONSTRAINT "+name+" "+kind+" "+ddl, err)
+	return e.self
+}
+
+func (e *TableElementsClause[T]) add(element string, err error) {
+	e.elements = append(e.elements, element)
+	if e.err == nil {
+		e.err = err
+	}
+	e.self.Dirty()
+}
+
+func (e *TableElementsClause[T]) HasTableElements() bool {
+	return len(e.elements) > 0
+}
+
+func (e *TableElementsClause[T]) CleanTableElements() T {
+	e.elements = nil
+	e.err = nil
+	e.self.Dirty()
+	return e.self
+}
+
+func (e *TableElementsClause[T]) CopyTableElements(self T) *TableElementsClause[T] {
+	return &TableElementsClause[T]{self, append([]string(nil), e.elements...), e.err}
+}
+
+func (e *TableElementsClause[T]) BuildTableElements() T {
+	if e.err != nil {
+		sqb.Fail(e.self, e.err)
+	}
+	if len(e.elements) > 0 {
+		e.self.AddSql(" (")
+		e.self.AddSql(strings.Join(e.elements, ", "))
+		e.self.AddSql(")")
+	}
+	return e.self
+}
@@ -0,0 +1,86 @@
+package clickhouse
+
+import (
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// Numbers returns the numbers table function, e.g. Numbers(10) is numbers(:p1) and Numbers(5, 10) is numbers(:p1, :p2).
+func Numbers(args ...int) *sql.TableFunctionExpression {
+	items := make([]any, 0, len(args))
+	for _, arg := range args {
+		items = append(items, arg)
+	}
+	return sql.NewTableFunctionExp("numbers", items...)
+}
+
+// File returns the file table function reading the server side file, the path is bound as a parameter,
+// the format is written as is and the structure is a string literal, the empty trailing arguments are omitted,
+// e.g. File("x.csv", "CSV", "a UInt8") is file(:p1, CSV, 'a UInt8').
+func File(path string, format string, structure string, compression ...string) *sql.TableFunctionExpression {
+	return sql.NewTableFunctionExp("file", fileArgs(path, format, structure, compression)...)
+}
+
+// Url returns the url table function, e.g. Url("https://example.com/data.csv", "CSV", "a UInt8")
+// is url(:p1, CSV, 'a UInt8').
+func Url(url string, format string, structure string) *sql.TableFunctionExpression {
+	return sql.NewTableFunctionExp("url", fileArgs(url, format, structure, nil)...)
+}
+
+// S3 returns the s3 table function accessing a public bucket, e.g. S3("https://bucket.s3.amazonaws.com/data.parquet",
+// "Parquet", "") is s3(:p1, Parquet).
+func S3(path string, format string, structure string, compression ...string) *sql.TableFunctionExpression {
+	return sql.NewTableFunctionExp("s3", fileArgs(path, format, structure, compression)...)
+}
+
+// S3WithCredentials returns the s3 table function with the access key, the credentials are bound as parameters,
+// e.g. s3(:p1, :p2, :p3, Parquet).
+func S3WithCredentials(
+	path string,
+	accessKeyId string,
+	secretAccessKey string,
+	format string,
+	structure string,
+	compression ...string,
+) *sql.TableFunctionExpression {
+	args := append([]any{path, accessKeyId, secretAccessKey}, fileArgs("", format, structure, compression)[1:]...)
+	return sql.NewTableFunctionExp("s3", args...)
+}
+
+// Remote returns the remote table function querying the table of the remote servers, the addresses and the credentials
+// are bound as parameters and the table is written as is:
+//   - Remote(addresses string, table string) is remote(:p1, db.table)
+//   - Remote(addresses string, table string, user string) is remote(:p1, db.table, :p2)
+//   - Remote(addresses string, table string, user string, password string) is remote(:p1, db.table, :p2, :p3)
+func Remote(addresses string, table string, credentials ...string) *sql.TableFunctionExpression {
+	args := []any{addresses, sql.NewExp(table)}
+	for _, credential := range credentials {
+		args = append(args, credential)
+	}
+	return sql.NewTableFunctionExp("remote", args...)
+}
+
+// Input returns the input table function of INSERT ... SELECT statements transforming the inserted data,
+// e.g. Input("a UInt8, b String") is input('a UInt8, b String').
+func Input(structure string) *sql.TableFunctionExpression {
+	return sql.NewTableFunctionExp("input", sql.NewExp(quoteString(structure)))
+}
+
+func fileArgs(path string, format string, structure string, compression []string) []any {
+	args := []any{path}
+	if format == "" && (structure != "" || len(compression) > 0) {
+		format = "auto"
+	}
+	if format != "" {
+		args = append(args, sql.NewExp(format))
+	}
+	if structure == "" && len(compression) > 0 {
+		structure = "auto"
+	}
+	if structure != "" {
+		args = append(args, sql.NewExp(quoteString(structure)))
+	}
+	if len(compression) > 0 {
+		args = append(args, sql.NewExp(quoteString(compression[0])))
+	}
+	return args
+}
@@ -3,6 +3,7 @@ package clickhouse
 import (
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
 )
 
 type ValueListClause[T sqb.ColumnsAwareStmt[T], Q sqb.QueryStmt[Q]] struct {
@@ -15,6 +16,19 @@ func NewValueListClause[T sqb.ColumnsAwareStmt[T], Q sqb.QueryStmt[Q]](self T) *
 	return &ValueListClause[T, Q]{sql.NewValueListClause[T, Q](self), false, self}
 }
 
+// Values add values and columns to the value list clause, the raw values are enclosed in parentheses:
+// Values(values any)
+// Values(values any, columns any)
+func (v *ValueListClause[T, Q]) Values(values any, args ...any) T {
+	switch values.(type) {
+	case string:
+		values = "(" + values.(string) + ")"
+	case exp.Expression:
+		values = exp.NewExpWithParams("("+values.(exp.Expression).String()+")", values.(exp.Expression).Params())
+	}
+	return v.ValueListClause.Values(values, args...)
+}
+
 func (v *ValueListClause[T, Q]) CopyValueList(self T) *ValueListClause[T, Q] {
 	return &ValueListClause[T, Q]{v.ValueListClause.CopyValueList(self), v.format, self}
 }
@@ -27,20 +41,19 @@ func (v *ValueListClause[T, Q]) FormatValueList() T {
 }
 
 func (v *ValueListClause[T, Q]) BuildValueList() T {
-	self, query, exp := v.ValueListClause.BuildValueList()
+	self, query, values := v.ValueListClause.BuildValueList()
 	if query != nil {
-		self.AddParams((*query).Params())
+		self.AddParams(exp.NestedParams(*query))
 		self.AddSql(" ")
 		self.AddSql((*query).String())
-	} else if exp.IsNotEmpty() {
+	} else if values.IsNotEmpty() {
 
 		if v.format {
 			self.AddSql(" FORMAT")
 		}
-		self.AddParams(exp.Params())
-		self.AddSql(" VALUES (")
-		self.AddSql(exp.String())
-		self.AddSql(")")
+		self.AddParams(values.Params())
+		self.AddSql(" VALUES ")
+		self.AddSql(values.String())
 	}
 	return self
 }
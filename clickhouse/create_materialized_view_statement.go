@@ -0,0 +1,83 @@
+package clickhouse
+
+import (
+	"errors"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+	"github.com/AlephTav/sqb/execution"
+	"github.com/AlephTav/sqb/sql"
+)
+
+type CreateMaterializedViewStmt struct {
+	*execution.StatementExecution[*CreateMaterializedViewStmt]
+	*sql.BaseStatement[*CreateMaterializedViewStmt]
+	*clickhouse.CreateMaterializedViewClause[*CreateMaterializedViewStmt]
+	*clickhouse.OnClusterClause[*CreateMaterializedViewStmt]
+	*clickhouse.RefreshClause[*CreateMaterializedViewStmt]
+	*clickhouse.TableElementsClause[*CreateMaterializedViewStmt]
+	*clickhouse.EngineClause[*CreateMaterializedViewStmt]
+	*clickhouse.AsQueryClause[*CreateMaterializedViewStmt, *SelectStmt]
+}
+
+func NewCreateMaterializedViewStmt(db sqb.StatementExecutor) *CreateMaterializedViewStmt {
+	st := &CreateMaterializedViewStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*CreateMaterializedViewStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateMaterializedViewStmt](st, db)
+	st.CreateMaterializedViewClause = clickhouse.NewCreateMaterializedViewClause[*CreateMaterializedViewStmt](st)
+	st.OnClusterClause = clickhouse.NewOnClusterClause[*CreateMaterializedViewStmt](st)
+	st.RefreshClause = clickhouse.NewRefreshClause[*CreateMaterializedViewStmt](st)
+	st.TableElementsClause = clickhouse.NewTableElementsClause[*CreateMaterializedViewStmt](st)
+	st.EngineClause = clickhouse.NewEngineClause[*CreateMaterializedViewStmt](st)
+	st.AsQueryClause = clickhouse.NewAsQueryClause[*CreateMaterializedViewStmt, *SelectStmt](st)
+	return st
+}
+
+func (s *CreateMaterializedViewStmt) ItIsCommand() {}
+
+func (s *CreateMaterializedViewStmt) Clean() *CreateMaterializedViewStmt {
+	s.CleanCreateMaterializedView()
+	s.CleanOnCluster()
+	s.CleanRefresh()
+	s.CleanTableElements()
+	s.CleanEngine()
+	s.CleanAsQuery()
+	return s
+}
+
+func (s *CreateMaterializedViewStmt) Copy() *CreateMaterializedViewStmt {
+	st := &CreateMaterializedViewStmt{}
+	st.CreateMaterializedViewClause = s.CopyCreateMaterializedView(st)
+	st.OnClusterClause = s.CopyOnCluster(st)
+	st.RefreshClause = s.CopyRefresh(st)
+	st.TableElementsClause = s.CopyTableElements(st)
+	st.EngineClause = s.CopyEngine(st)
+	st.AsQueryClause = s.CopyAsQuery(st)
+	st.StatementExecution = execution.NewStatementExecution[*CreateMaterializedViewStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateMaterializedViewStmt](st, s.Executor())
+	return st
+}
+
+func (s *CreateMaterializedViewStmt) Build() *CreateMaterializedViewStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildCreateMaterializedView()
+	s.BuildOnCluster()
+	s.BuildRefresh()
+	s.BuildTo()
+	s.BuildTableElements()
+	s.BuildEngine()
+	s.BuildPopulate()
+	s.BuildAsQuery()
+	if s.HasTo() && s.HasEngine() {
+		s.Fail(errors.New("materialized view with TO table cannot have the engine, set it for the target table"))
+	}
+	if s.HasTo() && s.IsPopulate() {
+		s.Fail(errors.New("materialized view with TO table cannot be populated, insert the data into the target table"))
+	}
+	bindParameters(s, s.BaseStatement.Expression.Params())
+	s.Built()
+	return s
+}
@@ -0,0 +1,123 @@
+package clickhouse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+)
+
+func TestCreateMaterializedViewStmt_ToTable(t *testing.T) {
+	st := NewCreateMaterializedViewStmt(nil).
+		View("daily_hits_mv").
+		IfNotExists().
+		OnCluster("main").
+		To("daily_hits").
+		As(
+			NewSelectStmt(nil).
+				Select("toDate(ts) AS day, count() AS hits").
+				From("hits").
+				Where("status = 200").
+				GroupBy("day"),
+		)
+
+	sqb.CheckSql(
+		t,
+		"CREATE MATERIALIZED VIEW IF NOT EXISTS daily_hits_mv ON CLUSTER main TO daily_hits "+
+			"AS SELECT toDate(ts) AS day, count() AS hits FROM hits WHERE status = 200 GROUP BY day",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+	if err := st.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestCreateMaterializedViewStmt_InvalidQuery(t *testing.T) {
+	st := NewCreateMaterializedViewStmt(sqb.NewStatementExecutorMock()).
+		View("daily_hits_mv").
+		To("daily_hits").
+		As(NewSelectStmt(nil).Select("toDate(ts) AS day").From("hits").Where("status", "=", 200))
+
+	if _, err := st.Exec(); err == nil || !strings.Contains(err.Error(), "cannot have parameters") {
+		t.Errorf("Exec() error = %v, expected the error about parameters of the view query", err)
+	}
+
+	st = NewCreateMaterializedViewStmt(sqb.NewStatementExecutorMock()).
+		View("daily_hits_mv").
+		To("daily_hits").
+		As(NewSelectStmt(nil).From("hits").Limit(5).WithTies())
+
+	if err := st.Validate(); err == nil || err.Error() != "LIMIT WITH TIES requires ORDER BY" {
+		t.Errorf("Validate() error = %v, expected the error of the view query", err)
+	}
+}
+
+func TestCreateMaterializedViewStmt_InnerEngineWithPopulate(t *testing.T) {
+	st := NewCreateMaterializedViewStmt(nil).
+		View("totals_mv").
+		Engine(clickhouse.SummingMergeTree()).
+		PartitionBy("toYYYYMM(day)").
+		OrderBy("day", "site").
+		Populate().
+		As(NewSelectStmt(nil).Select("day, site, sum(hits) AS hits").From("daily_hits").GroupBy("day, site"))
+
+	sqb.CheckSql(
+		t,
+		"CREATE MATERIALIZED VIEW totals_mv ENGINE = SummingMergeTree ORDER BY (day, site) "+
+			"PARTITION BY toYYYYMM(day) POPULATE "+
+			"AS SELECT day, site, sum(hits) AS hits FROM daily_hits GROUP BY day, site",
+		st.String(),
+	)
+}
+
+func TestCreateMaterializedViewStmt_Refreshable(t *testing.T) {
+	st := NewCreateMaterializedViewStmt(nil).
+		View("top_pages_mv").
+		RefreshEvery("1 HOUR").
+		RefreshOffset("5 MINUTE").
+		RandomizeFor("1 MINUTE").
+		DependsOn("db.hits_mv").
+		Append().
+		To("top_pages").
+		Column("page", "String").
+		Column("views", "UInt64").
+		As(NewSelectStmt(nil).Select("page, count() AS views").From("hits").GroupBy("page"))
+
+	sqb.CheckSql(
+		t,
+		"CREATE MATERIALIZED VIEW top_pages_mv REFRESH EVERY 1 HOUR OFFSET 5 MINUTE RANDOMIZE FOR 1 MINUTE "+
+			"DEPENDS ON db.hits_mv APPEND TO top_pages (page String, views UInt64) "+
+			"AS SELECT page, count() AS views FROM hits GROUP BY page",
+		st.String(),
+	)
+}
+
+func TestCreateMaterializedViewStmt_ToTableWithEngine(t *testing.T) {
+	views := []*CreateMaterializedViewStmt{
+		NewCreateMaterializedViewStmt(nil).View("mv").To("tb").Engine(clickhouse.MergeTree()),
+		NewCreateMaterializedViewStmt(nil).View("mv").To("tb").OrderBy("day"),
+		NewCreateMaterializedViewStmt(nil).View("mv").To("tb").Populate(),
+	}
+	for _, st := range views {
+		st.As(NewSelectStmt(nil).Select("day").From("hits"))
+		if err := st.Validate(); err == nil {
+			t.Errorf("Validate() error is nil for %q, expected the error about TO table", st.String())
+		}
+	}
+}
+
+func TestCreateMaterializedViewStmt_CopyAndClean(t *testing.T) {
+	st := NewCreateMaterializedViewStmt(nil).
+		View("mv").
+		RefreshAfter("30 SECOND").
+		To("tb").
+		As(NewSelectStmt(nil).From("src"))
+
+	copied := st.Copy().To("tb2").CleanRefresh()
+
+	sqb.CheckSql(t, "CREATE MATERIALIZED VIEW mv REFRESH AFTER 30 SECOND TO tb AS SELECT * FROM src", st.String())
+	sqb.CheckSql(t, "CREATE MATERIALIZED VIEW mv TO tb2 AS SELECT * FROM src", copied.String())
+	sqb.CheckSql(t, "CREATE MATERIALIZED VIEW", st.Clean().String())
+}
@@ -0,0 +1,68 @@
+package clickhouse
+
+import (
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+	"github.com/AlephTav/sqb/execution"
+	"github.com/AlephTav/sqb/sql"
+)
+
+type CreateTableStmt struct {
+	*execution.StatementExecution[*CreateTableStmt]
+	*sql.BaseStatement[*CreateTableStmt]
+	*clickhouse.CreateTableClause[*CreateTableStmt]
+	*clickhouse.OnClusterClause[*CreateTableStmt]
+	*clickhouse.TableElementsClause[*CreateTableStmt]
+	*clickhouse.EngineClause[*CreateTableStmt]
+	*clickhouse.SettingsClause[*CreateTableStmt]
+}
+
+func NewCreateTableStmt(db sqb.StatementExecutor) *CreateTableStmt {
+	st := &CreateTableStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*CreateTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateTableStmt](st, db)
+	st.CreateTableClause = clickhouse.NewCreateTableClause[*CreateTableStmt](st)
+	st.OnClusterClause = clickhouse.NewOnClusterClause[*CreateTableStmt](st)
+	st.TableElementsClause = clickhouse.NewTableElementsClause[*CreateTableStmt](st)
+	st.EngineClause = clickhouse.NewEngineClause[*CreateTableStmt](st)
+	st.SettingsClause = clickhouse.NewSettingsClause[*CreateTableStmt](st)
+	return st
+}
+
+func (s *CreateTableStmt) ItIsCommand() {}
+
+func (s *CreateTableStmt) Clean() *CreateTableStmt {
+	s.CleanCreateTable()
+	s.CleanOnCluster()
+	s.CleanTableElements()
+	s.CleanEngine()
+	s.CleanSettings()
+	return s
+}
+
+func (s *CreateTableStmt) Copy() *CreateTableStmt {
+	st := &CreateTableStmt{}
+	st.CreateTableClause = s.CopyCreateTable(st)
+	st.OnClusterClause = s.CopyOnCluster(st)
+	st.TableElementsClause = s.CopyTableElements(st)
+	st.EngineClause = s.CopyEngine(st)
+	st.SettingsClause = s.CopySettings(st)
+	st.StatementExecution = execution.NewStatementExecution[*CreateTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateTableStmt](st, s.Executor())
+	return st
+}
+
+func (s *CreateTableStmt) Build() *CreateTableStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildCreateTable()
+	s.BuildOnCluster()
+	s.BuildTableElements()
+	s.BuildEngine()
+	s.BuildSettings()
+	bindParameters(s, s.BaseStatement.Expression.Params())
+	s.Built()
+	return s
+}
@@ -0,0 +1,230 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+func TestCreateTableStmt_RawColumns(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("events").
+		IfNotExists().
+		Column("id", "UInt64").
+		Column("name", "String", "DEFAULT ''").
+		Engine(clickhouse.MergeTree()).
+		OrderBy("id")
+
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE IF NOT EXISTS events (id UInt64, name String DEFAULT '') ENGINE = MergeTree ORDER BY id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCreateTableStmt_ColumnTypes(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("hits").
+		Column(clickhouse.NewColumnDef("user_id", clickhouse.Nullable("UInt64"))).
+		Column(clickhouse.NewColumnDef("country", clickhouse.LowCardinality("String")).Comment("ISO code")).
+		Column(clickhouse.NewColumnDef("tags", clickhouse.Array("String"))).
+		Column(clickhouse.NewColumnDef("attrs", clickhouse.Map("String", clickhouse.Array("UInt8")))).
+		Column(clickhouse.NewColumnDef("point", clickhouse.Tuple("x Float64", "y Float64"))).
+		Column(clickhouse.NewColumnDef("ts", "DateTime64(3)").Codec("Delta", "ZSTD(3)")).
+		Column(clickhouse.NewColumnDef("d", "Date").Materialized("toDate(ts)")).
+		Column(clickhouse.NewColumnDef("hour", "UInt8").Alias(sql.NewExp("toHour(ts)"))).
+		Column(clickhouse.NewColumnDef("raw", "String").Default("''").TTL("d + INTERVAL 1 DAY")).
+		Column(clickhouse.NewColumnDef("unhexed", "String").Ephemeral()).
+		Engine(clickhouse.MergeTree()).
+		OrderBy()
+
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE hits ("+
+			"user_id Nullable(UInt64), "+
+			"country LowCardinality(String) COMMENT 'ISO code', "+
+			"tags Array(String), "+
+			"attrs Map(String, Array(UInt8)), "+
+			"point Tuple(x Float64, y Float64), "+
+			"ts DateTime64(3) CODEC(Delta, ZSTD(3)), "+
+			"d Date MATERIALIZED toDate(ts), "+
+			"hour UInt8 ALIAS toHour(ts), "+
+			"raw String DEFAULT '' TTL d + INTERVAL 1 DAY, "+
+			"unhexed String EPHEMERAL"+
+			") ENGINE = MergeTree ORDER BY tuple()",
+		st.String(),
+	)
+}
+
+func TestCreateTableStmt_TableOptions(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("db.visits").
+		OnCluster("main").
+		Column("CounterID", "UInt32").
+		Column("StartDate", "Date").
+		Column("UserID", "UInt64").
+		Column("Version", "UInt32").
+		Index("idx_user", "UserID", "bloom_filter(0.01)", 4).
+		Constraint("c_counter", "CounterID > 0").
+		Engine(clickhouse.ReplacingMergeTree("Version")).
+		PartitionBy("toYYYYMM(StartDate)").
+		OrderBy("CounterID", "StartDate", "intHash32(UserID)").
+		PrimaryKey("CounterID", "StartDate").
+		SampleBy("intHash32(UserID)").
+		TTL("StartDate + INTERVAL 1 MONTH TO VOLUME 'slow'").
+		TTL("StartDate + INTERVAL 1 YEAR DELETE").
+		Settings("index_granularity = 8192")
+
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE db.visits ON CLUSTER main ("+
+			"CounterID UInt32, StartDate Date, UserID UInt64, Version UInt32, "+
+			"INDEX idx_user UserID TYPE bloom_filter(0.01) GRANULARITY 4, "+
+			"CONSTRAINT c_counter CHECK CounterID > 0"+
+			") ENGINE = ReplacingMergeTree(Version) "+
+			"ORDER BY (CounterID, StartDate, intHash32(UserID)) "+
+			"PARTITION BY toYYYYMM(StartDate) "+
+			"PRIMARY KEY (CounterID, StartDate) "+
+			"SAMPLE BY intHash32(UserID) "+
+			"TTL StartDate + INTERVAL 1 MONTH TO VOLUME 'slow', StartDate + INTERVAL 1 YEAR DELETE "+
+			"SETTINGS index_granularity = 8192",
+		st.String(),
+	)
+}
+
+func TestCreateTableStmt_Projection(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("visits").
+		Column("user_id", "UInt64").
+		Column("ts", "DateTime").
+		Projection("p_user", NewSelectStmt(nil).Select("user_id, count()").GroupBy("user_id")).
+		Projection("p_ts", "SELECT * ORDER BY ts").
+		Engine(clickhouse.MergeTree()).
+		OrderBy("user_id")
+
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE visits (user_id UInt64, ts DateTime, "+
+			"PROJECTION p_user (SELECT user_id, count() GROUP BY user_id), "+
+			"PROJECTION p_ts (SELECT * ORDER BY ts)) ENGINE = MergeTree ORDER BY user_id",
+		st.String(),
+	)
+}
+
+func TestCreateTableStmt_ProjectionWithParameters(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewCreateTableStmt(nil).
+		Table("visits").
+		Column("user_id", "UInt64").
+		Projection("p_user", NewSelectStmt(nil).Select("user_id, count()").Where("user_id", ">", 0).GroupBy("user_id")).
+		Engine(clickhouse.MergeTree()).
+		OrderBy("user_id")
+
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about parameters of projection query")
+	}
+}
+
+func TestCreateTableStmt_Engines(t *testing.T) {
+	engines := []struct {
+		engine clickhouse.TableEngine
+		sql    string
+	}{
+		{clickhouse.MergeTree(), "MergeTree"},
+		{clickhouse.ReplacingMergeTree(), "ReplacingMergeTree"},
+		{clickhouse.ReplacingMergeTree("ver", "is_deleted"), "ReplacingMergeTree(ver, is_deleted)"},
+		{clickhouse.SummingMergeTree("a", "b"), "SummingMergeTree((a, b))"},
+		{clickhouse.AggregatingMergeTree(), "AggregatingMergeTree"},
+		{clickhouse.CollapsingMergeTree("sign"), "CollapsingMergeTree(sign)"},
+		{clickhouse.VersionedCollapsingMergeTree("sign", "ver"), "VersionedCollapsingMergeTree(sign, ver)"},
+		{clickhouse.ReplicatedMergeTree("/t/{shard}", "{replica}"), "ReplicatedMergeTree('/t/{shard}', '{replica}')"},
+		{
+			clickhouse.ReplicatedMergeTree("/t", "r", clickhouse.ReplacingMergeTree("ver")),
+			"ReplicatedReplacingMergeTree('/t', 'r', ver)",
+		},
+		{clickhouse.Distributed("main", "db", "hits_local", "rand()"), "Distributed(main, db, hits_local, rand())"},
+		{clickhouse.Memory(), "Memory"},
+		{clickhouse.Null(), "Null"},
+	}
+	for _, engine := range engines {
+		st := NewCreateTableStmt(nil).Table("tb").Column("a", "UInt8").Engine(engine.engine)
+		sqb.CheckSql(t, "CREATE TABLE tb (a UInt8) ENGINE = "+engine.sql, st.String())
+	}
+}
+
+func TestCreateTableStmt_LiteralValues(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("tb").
+		Column(clickhouse.NewColumnDef("qty", "UInt32").Default(0)).
+		Column(clickhouse.NewColumnDef("active", "Bool").Default(true)).
+		Column(clickhouse.NewColumnDef("ratio", "Float64").Default(0.5)).
+		Engine(clickhouse.MergeTree()).
+		OrderBy("qty")
+
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE tb (qty UInt32 DEFAULT 0, active Bool DEFAULT TRUE, ratio Float64 DEFAULT 0.5) "+
+			"ENGINE = MergeTree ORDER BY qty",
+		st.String(),
+	)
+}
+
+func TestCreateTableStmt_ExpressionWithParameters(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewCreateTableStmt(sqb.NewStatementExecutorMock()).
+		Table("tb").
+		Column("qty", "UInt32").
+		Constraint("c_qty", sql.NewCondExp("qty", ">=", 0)).
+		Engine(clickhouse.MergeTree())
+
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about parameters of DDL expression")
+	}
+	if _, err := st.Exec(); err == nil {
+		t.Error("Exec() error is nil, expected the error about parameters of DDL expression")
+	}
+
+	st = NewCreateTableStmt(nil).
+		Table("tb").
+		Column("qty", "UInt32").
+		Engine(clickhouse.MergeTree()).
+		OrderBy(sql.NewCondExp("qty", ">", 1))
+
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about parameters of DDL expression")
+	}
+}
+
+func TestCreateTableStmt_InvalidReplicatedEngine(t *testing.T) {
+	for _, engine := range []clickhouse.TableEngine{
+		clickhouse.ReplicatedMergeTree("/t", "r", clickhouse.Memory()),
+		clickhouse.ReplicatedMergeTree("/t", "r", clickhouse.ReplicatedMergeTree("/t", "r")),
+	} {
+		st := NewCreateTableStmt(nil).Table("tb").Column("a", "UInt8").Engine(engine)
+		if err := st.Validate(); err == nil {
+			t.Errorf("Validate() error is nil, expected the error about engine %s", engine)
+		}
+	}
+}
+
+func TestCreateTableStmt_CopyAndClean(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		OrReplace().
+		Table("tb").
+		Column("a", "UInt8").
+		Engine(clickhouse.MergeTree()).
+		OrderBy("a")
+
+	copied := st.Copy().Table("tb2").OrderBy("b").Settings("storage_policy = 'hot'")
+
+	sqb.CheckSql(t, "CREATE OR REPLACE TABLE tb (a UInt8) ENGINE = MergeTree ORDER BY a", st.String())
+	sqb.CheckSql(
+		t,
+		"CREATE OR REPLACE TABLE tb2 (a UInt8) ENGINE = MergeTree ORDER BY (a, b) SETTINGS storage_policy = 'hot'",
+		copied.String(),
+	)
+	sqb.CheckSql(t, "CREATE TABLE", st.Clean().String())
+}
@@ -0,0 +1,76 @@
+package clickhouse
+
+import (
+	"errors"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+	"github.com/AlephTav/sqb/execution"
+	"github.com/AlephTav/sqb/sql"
+	cls "github.com/AlephTav/sqb/sql/clause"
+)
+
+// DeleteStmt is the lightweight DELETE statement marking the matched rows as deleted.
+// It fails without WHERE condition, Where("1") deletes all rows.
+type DeleteStmt struct {
+	*execution.StatementExecution[*DeleteStmt]
+	*sql.BaseStatement[*DeleteStmt]
+	*clickhouse.DeleteClause[*DeleteStmt]
+	*clickhouse.OnClusterClause[*DeleteStmt]
+	*clickhouse.InPartitionClause[*DeleteStmt]
+	*cls.WhereClause[*DeleteStmt]
+	*clickhouse.SettingsClause[*DeleteStmt]
+}
+
+func NewDeleteStmt(db sqb.StatementExecutor) *DeleteStmt {
+	st := &DeleteStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*DeleteStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*DeleteStmt](st, db)
+	st.DeleteClause = clickhouse.NewDeleteClause[*DeleteStmt](st)
+	st.OnClusterClause = clickhouse.NewOnClusterClause[*DeleteStmt](st)
+	st.InPartitionClause = clickhouse.NewInPartitionClause[*DeleteStmt](st)
+	st.WhereClause = cls.NewWhereClause[*DeleteStmt](st)
+	st.SettingsClause = clickhouse.NewSettingsClause[*DeleteStmt](st)
+	return st
+}
+
+func (s *DeleteStmt) ItIsCommand() {}
+
+func (s *DeleteStmt) Clean() *DeleteStmt {
+	s.CleanDelete()
+	s.CleanOnCluster()
+	s.CleanInPartition()
+	s.CleanWhere()
+	s.CleanSettings()
+	return s
+}
+
+func (s *DeleteStmt) Copy() *DeleteStmt {
+	st := &DeleteStmt{}
+	st.DeleteClause = s.CopyDelete(st)
+	st.OnClusterClause = s.CopyOnCluster(st)
+	st.InPartitionClause = s.CopyInPartition(st)
+	st.WhereClause = s.CopyWhere(st)
+	st.SettingsClause = s.CopySettings(st)
+	st.StatementExecution = execution.NewStatementExecution[*DeleteStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*DeleteStmt](st, s.Executor())
+	return st
+}
+
+func (s *DeleteStmt) Build() *DeleteStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildDelete()
+	s.BuildOnCluster()
+	s.BuildInPartition()
+	s.BuildWhere()
+	if !s.HasWhere() {
+		s.Fail(errors.New("DELETE requires WHERE condition, use Where(\"1\") to delete all rows"))
+	}
+	s.BuildSettings()
+	bindParameters(s, s.BaseStatement.Expression.Params())
+	s.Built()
+	return s
+}
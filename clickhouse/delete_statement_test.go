@@ -0,0 +1,56 @@
+package clickhouse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlephTav/sqb"
+)
+
+func TestDeleteStmt_Simple(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewDeleteStmt(nil).
+		From("visits").
+		Where("user_id", "=", 5).
+		AndWhere("created_at < now() - INTERVAL 1 YEAR")
+
+	sqb.CheckSql(t, "DELETE FROM visits WHERE user_id = :p1 AND created_at < now() - INTERVAL 1 YEAR", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": 5}, st.Params())
+}
+
+func TestDeleteStmt_OnClusterInPartitionWithSettings(t *testing.T) {
+	st := NewDeleteStmt(nil).
+		From("db.visits").
+		OnCluster("main").
+		InPartition("202401").
+		Where("a = 1").
+		Settings("lightweight_deletes_sync = 2")
+
+	sqb.CheckSql(
+		t,
+		"DELETE FROM db.visits ON CLUSTER main IN PARTITION 202401 WHERE a = 1 SETTINGS lightweight_deletes_sync = 2",
+		st.String(),
+	)
+}
+
+func TestDeleteStmt_CopyAndClean(t *testing.T) {
+	st := NewDeleteStmt(nil).From("visits").OnCluster("main").Where("a = 1")
+	copied := st.Copy().Where("b = 2")
+
+	sqb.CheckSql(t, "DELETE FROM visits ON CLUSTER main WHERE a = 1", st.String())
+	sqb.CheckSql(t, "DELETE FROM visits ON CLUSTER main WHERE a = 1 AND b = 2", copied.String())
+	sqb.CheckSql(t, "DELETE FROM", st.Clean().String())
+}
+
+func TestDeleteStmt_WithoutWhere(t *testing.T) {
+	st := NewDeleteStmt(sqb.NewStatementExecutorMock()).
+		From("visits").
+		InPartition("202401")
+
+	if _, err := st.Exec(); err == nil || !strings.Contains(err.Error(), "requires WHERE condition") {
+		t.Errorf("Exec() error = %v, expected the error about the missing WHERE condition", err)
+	}
+	if err := st.Where("1").Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package clickhouse
 
 import (
+	"bytes"
+	"strings"
+
 	"github.com/AlephTav/sqb"
 
 	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
@@ -11,6 +14,7 @@ import (
 
 type InsertStmt struct {
 	*execution.DataFetching[*InsertStmt]
+	*execution.BodyExecution[*InsertStmt]
 	*sql.BaseStatement[*InsertStmt]
 	*clickhouse.InsertClause[*InsertStmt]
 	*clickhouse.ValueListClause[*InsertStmt, *SelectStmt]
@@ -19,11 +23,15 @@ type InsertStmt struct {
 	*clickhouse.SettingsClause[*InsertStmt]
 	*clickhouse.FromClause[*InsertStmt]
 	*clickhouse.FormatClause[*InsertStmt]
+	*clickhouse.BodyClause[*InsertStmt]
+
+	executed bool
 }
 
 func NewInsertStmt(db sqb.StatementExecutor) *InsertStmt {
 	st := &InsertStmt{}
 	st.DataFetching = execution.NewDataFetching[*InsertStmt](st)
+	st.BodyExecution = execution.NewBodyExecution[*InsertStmt](st)
 	st.BaseStatement = sql.NewBaseStatement[*InsertStmt](st, db)
 	st.InsertClause = clickhouse.NewInsertClause[*InsertStmt](st)
 	st.ValueListClause = clickhouse.NewValueListClause[*InsertStmt, *SelectStmt](st)
@@ -31,12 +39,24 @@ func NewInsertStmt(db sqb.StatementExecutor) *InsertStmt {
 	st.SettingsClause = clickhouse.NewSettingsClause[*InsertStmt](st)
 	st.FromClause = clickhouse.NewFromClause[*InsertStmt](st)
 	st.FormatClause = clickhouse.NewFormatClause[*InsertStmt](st)
+	st.BodyClause = clickhouse.NewBodyClause[*InsertStmt](st)
 
 	return st
 }
 
 func (s *InsertStmt) ItIsCommand() {}
 
+// ExecutedString returns the SQL of the executed statement including the default settings of the executor.
+func (s *InsertStmt) ExecutedString() string {
+	s.executed = true
+	s.Dirty()
+	defer func() {
+		s.executed = false
+		s.Dirty()
+	}()
+	return s.String()
+}
+
 func (s *InsertStmt) Clean() *InsertStmt {
 	s.CleanColumns()
 	s.CleanInsert()
@@ -44,6 +64,7 @@ func (s *InsertStmt) Clean() *InsertStmt {
 	s.CleanSettings()
 	s.CleanFrom()
 	s.CleanFormat()
+	s.CleanBody()
 
 	return s
 }
@@ -52,6 +73,7 @@ func (s *InsertStmt) Copy() *InsertStmt {
 	st := &InsertStmt{}
 
 	st.DataFetching = execution.NewDataFetching[*InsertStmt](st)
+	st.BodyExecution = execution.NewBodyExecution[*InsertStmt](st)
 	st.BaseStatement = sql.NewBaseStatement[*InsertStmt](st, s.Executor())
 	st.InsertClause = s.CopyInsert(st)
 	st.ColumnsClause = s.CopyColumns(st)
@@ -59,6 +81,7 @@ func (s *InsertStmt) Copy() *InsertStmt {
 	st.SettingsClause = s.CopySettings(st)
 	st.FromClause = s.CopyFrom(st)
 	st.FormatClause = s.CopyFormat(st)
+	st.BodyClause = s.CopyBody(st)
 
 	return st
 }
@@ -70,18 +93,69 @@ func (s *InsertStmt) Build() *InsertStmt {
 	s.BaseStatement.Clean()
 	s.BuildInsert()
 	s.BuildColumns()
-	s.BuildSettings()
+	s.BuildInfile()
+	if s.executed {
+		s.BuildSettingsWithDefaults()
+	} else {
+		s.BuildSettings()
+	}
 	s.BuildValueList()
 	s.BuildFrom()
 	s.BuildFormat()
+	bindParameters(s, s.BaseStatement.Expression.Params())
 	s.Built()
 	return s
 }
 
 func (s *InsertStmt) MustExec(sequence string) any {
-	return s.Executor().MustInsert(s.String(), s.Params(), sequence)
+	if err := s.Validate(); err != nil {
+		panic(err)
+	}
+	return s.Executor().MustInsert(s.ExecutedString(), s.Params(), sequence)
 }
 
 func (s *InsertStmt) Exec(sequence string) (any, error) {
-	return s.Executor().Insert(s.String(), s.Params(), sequence)
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s.Executor().Insert(s.ExecutedString(), s.Params(), sequence)
+}
+
+// DefaultBulkRows is the default number of rows of every statement of the bulk insert.
+const DefaultBulkRows = 10000
+
+// Bulk splits the rows into statements having at most DefaultBulkRows rows.
+// The limits can be changed with MaxParams and MaxRows of the returned bulk insert.
+func (s *InsertStmt) Bulk(rows []map[string]any) *execution.BulkInsert[*InsertStmt] {
+	return execution.NewBulkInsert[*InsertStmt](s, rows).MaxRows(DefaultBulkRows)
+}
+
+// Encode sends the rows in the statement body encoded by the encoder, the rows are a slice of maps or structs.
+// The columns of the rows and the format of the encoder replace the columns and the FORMAT clause of the statement,
+// e.g. INSERT INTO t (a, b) FORMAT JSONEachRow.
+func (s *InsertStmt) Encode(encoder clickhouse.Encoder, rows any) *InsertStmt {
+	s.EncodeBody(encoder, rows)
+	s.CleanColumns()
+	if columns := s.BodyColumns(); len(columns) > 0 {
+		s.Columns(strings.Join(columns, ", "))
+	}
+	s.CleanFormat()
+	return s.Format(encoder.Format())
+}
+
+func (s *InsertStmt) MustExecEncoded() int64 {
+	r, err := s.ExecEncoded()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// ExecEncoded executes the statement with the encoded rows in the body by the executor implementing sqb.BodyExecutor.
+func (s *InsertStmt) ExecEncoded() (int64, error) {
+	body, err := s.Body()
+	if err != nil {
+		return 0, err
+	}
+	return s.ExecWithBody(bytes.NewReader(body))
 }
@@ -1,12 +1,28 @@
 package clickhouse
 
 import (
-	"fmt"
+	"bytes"
+	"io"
 	"testing"
+	"time"
 
 	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
 )
 
+type bodyExecutorMock struct {
+	sqb.StatementExecutorMock
+	sql  string
+	body []byte
+}
+
+func (m *bodyExecutorMock) ExecWithBody(sql string, params map[string]any, body io.Reader) (int64, error) {
+	m.sql = sql
+	data, err := io.ReadAll(body)
+	m.body = data
+	return int64(bytes.Count(data, []byte("\n"))), err
+}
+
 func TestInsertDefault(t *testing.T) {
 	st := NewInsertStmt(nil).
 		Into("insert_select_testtable (*)").
@@ -47,9 +63,217 @@ func TestInsertSettingsFormat(t *testing.T) {
 func TestInsertSettingsFromInfile(t *testing.T) {
 	st := NewInsertStmt(nil).
 		Into("table").
-		FromInfile("'data.csv'").
+		FromInfile("data.csv").
 		Format("CSV")
 	sqb.CheckSql(t, "INSERT INTO table FROM INFILE 'data.csv' FORMAT CSV", st.String())
 	sqb.CheckParams(t, map[string]any{}, st.Params())
-	fmt.Println(st.String())
+}
+
+func TestInsertFromInfileWithCompression(t *testing.T) {
+	st := NewInsertStmt(nil).
+		Into("table").
+		Columns("a, b").
+		FromInfile("input_'1'.csv.gz", "gzip").
+		Settings("input_format_allow_errors_num = 10").
+		Format("CSV")
+	sqb.CheckSql(
+		t,
+		`INSERT INTO table (a, b) FROM INFILE 'input_\'1\'.csv.gz' COMPRESSION 'gzip' `+
+			"SETTINGS input_format_allow_errors_num = 10 FORMAT CSV",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestInsertValuesMap(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewInsertStmt(nil).
+		Into("table").
+		Values([]map[string]any{{"a": 1}, {"a": 2}})
+
+	sqb.CheckSql(t, "INSERT INTO table (a) VALUES (:p1), (:p2)", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": 2}, st.Params())
+}
+
+func TestInsertBulk(t *testing.T) {
+	sqb.ResetParameterIndex()
+	rows := []map[string]any{{"a": 1, "b": "x"}, {"a": 2, "b": "y"}, {"a": 3, "b": "z"}}
+	statements, err := NewInsertStmt(nil).
+		Into("table").
+		Settings("async_insert = 1").
+		Bulk(rows).
+		MaxRows(2).
+		Statements()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 2 {
+		t.Fatalf("Expected 2 statements, actual are %d", len(statements))
+	}
+	sqb.CheckSql(t, "INSERT INTO table (a, b) SETTINGS async_insert = 1 VALUES (:p1, :p2), (:p3, :p4)", statements[0].String())
+	sqb.CheckSql(t, "INSERT INTO table (a, b) SETTINGS async_insert = 1 VALUES (:p5, :p6)", statements[1].String())
+}
+
+func checkBody(t *testing.T, st *InsertStmt, expected string) {
+	body, err := st.Body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != expected {
+		t.Errorf("Expected body is %q, actual is %q", expected, body)
+	}
+}
+
+func TestInsertEncodeJSONEachRow(t *testing.T) {
+	st := NewInsertStmt(nil).
+		Into("events").
+		Encode(clickhouse.JSONEachRow, []map[string]any{
+			{"id": 1, "name": `a"b`, "tags": []any{"x", "y"}, "score": nil},
+			{"id": 2, "name": "c", "tags": []any{}, "score": 0.5},
+		})
+
+	sqb.CheckSql(t, "INSERT INTO events (id, name, score, tags) FORMAT JSONEachRow", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+	checkBody(
+		t,
+		st,
+		`{"id":1,"name":"a\"b","score":null,"tags":["x","y"]}`+"\n"+
+			`{"id":2,"name":"c","score":0.5,"tags":[]}`+"\n",
+	)
+}
+
+func TestInsertEncodeCSVWithNames(t *testing.T) {
+	type event struct {
+		ID        int `db:"id"`
+		Name      string
+		CreatedAt time.Time
+		Ratio     *float64
+	}
+	ratio := 0.25
+	st := NewInsertStmt(nil).
+		Into("events").
+		Encode(clickhouse.CSVWithNames, []event{
+			{1, "a,b", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), nil},
+			{2, "plain", time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC), &ratio},
+		})
+
+	sqb.CheckSql(t, "INSERT INTO events (id, name, created_at, ratio) FORMAT CSVWithNames", st.String())
+	checkBody(
+		t,
+		st,
+		"id,name,created_at,ratio\n"+
+			`1,"a,b",2024-01-02 03:04:05,\N`+"\n"+
+			"2,plain,2024-01-02 03:04:05.5,0.25\n",
+	)
+}
+
+func TestInsertEncodeTabSeparated(t *testing.T) {
+	st := NewInsertStmt(nil).
+		Into("events").
+		Encode(clickhouse.TabSeparated, []sqb.SliceMap{
+			{"s", "a\tb\\c\nd", "arr", []any{"it's", 1}, "n", nil, "b", true, "m", map[string]int{"y": 2, "x": 1}},
+		})
+
+	sqb.CheckSql(t, "INSERT INTO events (s, arr, n, b, m) FORMAT TabSeparated", st.String())
+	checkBody(t, st, `a\tb\\c\nd`+"\t"+`['it\'s',1]`+"\t"+`\N`+"\ttrue\t{'x':1,'y':2}\n")
+}
+
+func TestInsertEncodeRowBinary(t *testing.T) {
+	var id [16]byte
+	for i := range id {
+		id[i] = byte(i)
+	}
+	opt := int8(-1)
+	st := NewInsertStmt(nil).
+		Into("events").
+		Encode(clickhouse.RowBinary, []sqb.SliceMap{
+			{
+				"id", uint32(1),
+				"name", "ab",
+				"none", (*int8)(nil),
+				"opt", &opt,
+				"arr", []int16{1, 2},
+				"uuid", id,
+				"ts", time.Unix(0, 258),
+				"ok", true,
+			},
+		})
+
+	sqb.CheckSql(t, "INSERT INTO events (id, name, none, opt, arr, uuid, ts, ok) FORMAT RowBinary", st.String())
+	checkBody(t, st, string([]byte{
+		0x01, 0x00, 0x00, 0x00,
+		0x02, 'a', 'b',
+		0x01,
+		0x00, 0xff,
+		0x02, 0x01, 0x00, 0x02, 0x00,
+		0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01, 0x00, 0x0f, 0x0e, 0x0d, 0x0c, 0x0b, 0x0a, 0x09, 0x08,
+		0x02, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x01,
+	}))
+}
+
+func TestInsertEncodeErrors(t *testing.T) {
+	_, err := NewInsertStmt(nil).Into("events").Encode(clickhouse.JSONEachRow, 42).Body()
+	if err == nil || err.Error() != "rows of type int cannot be encoded, a slice of maps or structs is expected" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, err = NewInsertStmt(nil).
+		Into("events").
+		Encode(clickhouse.JSONEachRow, []map[string]any{{"a": 1}, {"b": 2}}).
+		Body()
+	if err == nil || err.Error() != "row 1 has column b instead of a" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, err = NewInsertStmt(nil).
+		Into("events").
+		Encode(clickhouse.RowBinary, []map[string]any{{"a": struct{}{}}}).
+		Body()
+	if err == nil || err.Error() != "value of type struct {} cannot be encoded in RowBinary format" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, err = NewInsertStmt(nil).
+		Into("events").
+		Encode(clickhouse.RowBinary, []map[string]any{{"a": nil}}).
+		Body()
+	if err == nil || err.Error() != "nil cannot be encoded in RowBinary format, use the nil pointer for Nullable column" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestInsertExecEncoded(t *testing.T) {
+	db := &bodyExecutorMock{}
+	n, err := NewInsertStmt(db).
+		Into("events").
+		Settings("async_insert = 1").
+		Encode(clickhouse.JSONEachRow, []map[string]any{{"id": 1}, {"id": 2}}).
+		ExecEncoded()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 || string(db.body) != "{\"id\":1}\n{\"id\":2}\n" {
+		t.Errorf("Unexpected body %q", db.body)
+	}
+	sqb.CheckSql(t, "INSERT INTO events (id) SETTINGS async_insert = 1 FORMAT JSONEachRow", db.sql)
+
+	_, err = NewInsertStmt(sqb.NewStatementExecutorMock()).
+		Into("events").
+		Encode(clickhouse.JSONEachRow, []map[string]any{{"id": 1}}).
+		ExecEncoded()
+	if err == nil || err.Error() != "statement executor does not support sending of statement body" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestInsertCopyEncoded(t *testing.T) {
+	origin := NewInsertStmt(nil).Into("events").Encode(clickhouse.TabSeparated, []map[string]any{{"id": 1}})
+	copied := origin.Copy()
+
+	sqb.CheckSql(t, "INSERT INTO events (id) FORMAT TabSeparated", copied.String())
+	checkBody(t, copied, "1\n")
+	checkBody(t, origin.Clean(), "")
 }
@@ -0,0 +1,203 @@
+package clickhouse
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+)
+
+// TypedParam is the parameter bound by the server, it is written as {name:Type} instead of :name
+// interpolated by the client.
+type TypedParam struct {
+	Value any
+	Type  string
+	err   error
+}
+
+// Param makes the value the typed parameter bound by the server:
+//   - Param(value any) infers the ClickHouse type from the value, see TypeOf
+//   - Param(value any, chType string) sets the type explicitly, e.g. Param(id, "UUID") or Param("en", "LowCardinality(String)")
+//
+// The statement using the parameter fails if the type cannot be inferred from the value.
+func Param(value any, args ...string) TypedParam {
+	if len(args) > 0 && args[0] != "" {
+		return TypedParam{value, args[0], nil}
+	}
+	chType := TypeOf(value)
+	if chType == "" {
+		return TypedParam{value, chType, fmt.Errorf("cannot infer ClickHouse type of value of type %T, pass the type to Param", value)}
+	}
+	return TypedParam{value, chType, nil}
+}
+
+// Placeholder returns the placeholder {name:Type} written instead of :name.
+func (p TypedParam) Placeholder(name string) string {
+	return "{" + name + ":" + p.Type + "}"
+}
+
+// TypedParametersExecutor is the optional capability of a StatementExecutor to bind the typed parameters on the server.
+// The executor gets the values of the typed parameters as TypedParam among the statement parameters,
+// ServerParameters separates them from the parameters interpolated by the client.
+type TypedParametersExecutor interface {
+	ItBindsTypedParameters()
+}
+
+// ServerParameters splits the statement parameters into the parameters interpolated by the client
+// and the typed parameters in the escaped text format, e.g. they are sent as param_<name> of the HTTP interface
+// or as the parameters of the native protocol query.
+func ServerParameters(params map[string]any) (map[string]any, map[string]string) {
+	client := make(map[string]any, len(params))
+	server := make(map[string]string)
+	for name, value := range params {
+		if typed, ok := value.(TypedParam); ok {
+			server[name] = clickhouse.EscapedText(typed.Value)
+		} else {
+			client[name] = value
+		}
+	}
+	return client, server
+}
+
+var errTypedParameters = errors.New("statement executor does not support typed parameters, see TypedParametersExecutor")
+
+// bindParameters fails the statement having typed parameters without the type
+// or if its executor cannot bind them on the server.
+func bindParameters[T sqb.Statement[T]](s T, params map[string]any) {
+	for _, value := range params {
+		if typed, ok := value.(TypedParam); ok && typed.err != nil {
+			sqb.Fail(s, typed.err)
+			return
+		}
+	}
+	if s.Executor() == nil {
+		return
+	}
+	if _, ok := s.Executor().(TypedParametersExecutor); ok {
+		return
+	}
+	for _, value := range params {
+		if _, ok := value.(TypedParam); ok {
+			sqb.Fail(s, errTypedParameters)
+			return
+		}
+	}
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf([16]byte{})
+)
+
+// TypeOf returns the ClickHouse type of the Go value:
+//   - integers and floats are Int8-Int64, UInt8-UInt64, Float32 and Float64
+//   - strings and byte slices are String, booleans are Bool
+//   - time.Time is DateTime64(9, 'UTC') since its text value is in UTC
+//   - [16]byte and its named types like uuid.UUID are UUID
+//   - slices and arrays are Array(T), maps are Map(K, V), pointers are Nullable(T) unless T cannot be inside Nullable,
+//     e.g. *[]int is Array(Int64)
+//
+// It returns the empty string if the value has no ClickHouse type, e.g. it is a struct or a channel.
+func TypeOf(value any) string {
+	if value == nil {
+		return "Nullable(Nothing)"
+	}
+	if typed, ok := value.(TypedParam); ok {
+		return typed.Type
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Interface && v.Len() > 0 {
+			return compositeType("Array", TypeOf(v.Index(0).Interface()))
+		}
+	case reflect.Map:
+		if v.Len() > 0 {
+			iter := v.MapRange()
+			iter.Next()
+			keyType, valueType := typeOf(v.Type().Key()), typeOf(v.Type().Elem())
+			if v.Type().Key().Kind() == reflect.Interface {
+				keyType = TypeOf(iter.Key().Interface())
+			}
+			if v.Type().Elem().Kind() == reflect.Interface {
+				valueType = TypeOf(iter.Value().Interface())
+			}
+			return compositeType("Map", keyType, valueType)
+		}
+	}
+	return typeOf(v.Type())
+}
+
+func typeOf(t reflect.Type) string {
+	if t == timeType {
+		return "DateTime64(9, 'UTC')"
+	}
+	if t.ConvertibleTo(uuidType) && t.Kind() == reflect.Array {
+		return "UUID"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "Bool"
+	case reflect.Int, reflect.Int64:
+		return "Int64"
+	case reflect.Int8:
+		return "Int8"
+	case reflect.Int16:
+		return "Int16"
+	case reflect.Int32:
+		return "Int32"
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return "UInt64"
+	case reflect.Uint8:
+		return "UInt8"
+	case reflect.Uint16:
+		return "UInt16"
+	case reflect.Uint32:
+		return "UInt32"
+	case reflect.Float32:
+		return "Float32"
+	case reflect.Float64:
+		return "Float64"
+	case reflect.String:
+		return "String"
+	case reflect.Interface:
+		return "Nothing"
+	case reflect.Pointer:
+		elemType := typeOf(t.Elem())
+		for _, prefix := range notNullableTypes {
+			if strings.HasPrefix(elemType, prefix) {
+				return elemType
+			}
+		}
+		return compositeType("Nullable", elemType)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "String"
+		}
+		return compositeType("Array", typeOf(t.Elem()))
+	case reflect.Array:
+		return compositeType("Array", typeOf(t.Elem()))
+	case reflect.Map:
+		return compositeType("Map", typeOf(t.Key()), typeOf(t.Elem()))
+	default:
+		return ""
+	}
+}
+
+// notNullableTypes are the prefixes of the types that cannot be inside Nullable.
+var notNullableTypes = []string{"Array(", "Map(", "Tuple(", "Nullable("}
+
+// compositeType returns the type composed of the given types, e.g. Map(String, Int64),
+// it is unknown if any of the given types is unknown.
+func compositeType(name string, types ...string) string {
+	for _, t := range types {
+		if t == "" {
+			return ""
+		}
+	}
+	return name + "(" + strings.Join(types, ", ") + ")"
+}
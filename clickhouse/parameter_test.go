@@ -0,0 +1,139 @@
+package clickhouse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AlephTav/sqb"
+)
+
+type uuid [16]byte
+
+func TestTypeOf(t *testing.T) {
+	var n *int32
+	tests := []struct {
+		value    any
+		expected string
+	}{
+		{true, "Bool"},
+		{1, "Int64"},
+		{int8(1), "Int8"},
+		{int16(1), "Int16"},
+		{int32(1), "Int32"},
+		{uint(1), "UInt64"},
+		{uint8(1), "UInt8"},
+		{uint16(1), "UInt16"},
+		{uint32(1), "UInt32"},
+		{float32(1), "Float32"},
+		{1.5, "Float64"},
+		{"a", "String"},
+		{[]byte("a"), "String"},
+		{time.Now(), "DateTime64(9, 'UTC')"},
+		{uuid{}, "UUID"},
+		{n, "Nullable(Int32)"},
+		{[]uint64{1}, "Array(UInt64)"},
+		{[][]string{{"a"}}, "Array(Array(String))"},
+		{[]any{"a", 1}, "Array(String)"},
+		{map[string]int{}, "Map(String, Int64)"},
+		{map[string]any{"a": 1.5}, "Map(String, Float64)"},
+		{Param("a", "LowCardinality(String)"), "LowCardinality(String)"},
+		{&[]int{1}, "Array(Int64)"},
+		{&map[string]int{}, "Map(String, Int64)"},
+		{&n, "Nullable(Int32)"},
+		{[]any{}, "Array(Nothing)"},
+		{struct{}{}, ""},
+		{[]chan int{}, ""},
+		{map[string]any{"a": struct{}{}}, ""},
+	}
+	for _, test := range tests {
+		if actual := TypeOf(test.value); actual != test.expected {
+			t.Errorf("Expected type of %#v is %q, actual is %q", test.value, test.expected, actual)
+		}
+	}
+}
+
+type typedParametersExecutorMock struct {
+	sqb.StatementExecutorMock
+	sql    string
+	params map[string]any
+	server map[string]string
+}
+
+func (m *typedParametersExecutorMock) ItBindsTypedParameters() {}
+
+func (m *typedParametersExecutorMock) Exec(sql string, params map[string]any) (int64, error) {
+	m.sql = sql
+	m.params, m.server = ServerParameters(params)
+	return 1, nil
+}
+
+func TestSelectStmt_TypedParameters(t *testing.T) {
+	sqb.ResetParameterIndex()
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	st := NewSelectStmt(nil).
+		From("hits").
+		Where("user_id", "=", Param(uint64(7))).
+		Where("ts", ">=", Param(ts)).
+		Where("tags", "=", Param([]string{"a", "b"})).
+		Where("lang", "=", Param("en", "LowCardinality(String)")).
+		Where("status", "=", 200).
+		Where("url", "=", "':p1'")
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM hits WHERE user_id = {p1:UInt64} AND ts >= {p2:DateTime64(9, 'UTC')} "+
+			"AND tags = {p3:Array(String)} AND lang = {p4:LowCardinality(String)} AND status = :p5 AND url = :p6",
+		st.String(),
+	)
+	sqb.CheckParams(
+		t,
+		map[string]any{
+			"p1": Param(uint64(7)),
+			"p2": Param(ts),
+			"p3": Param([]string{"a", "b"}),
+			"p4": Param("en", "LowCardinality(String)"),
+			"p5": 200,
+			"p6": "':p1'",
+		},
+		st.Params(),
+	)
+}
+
+func TestDeleteStmt_TypedParametersExecutor(t *testing.T) {
+	sqb.ResetParameterIndex()
+	db := &typedParametersExecutorMock{}
+	st := NewDeleteStmt(db).
+		From("hits").
+		Where("name", "=", Param("a\tb\nc")).
+		Where("ids", "=", Param([]string{"x'y"})).
+		Where("status", "=", 200)
+
+	if _, err := st.Exec(); err != nil {
+		t.Fatal(err)
+	}
+	sqb.CheckSql(t, "DELETE FROM hits WHERE name = {p1:String} AND ids = {p2:Array(String)} AND status = :p3", db.sql)
+	sqb.CheckParams(t, map[string]any{"p3": 200}, db.params)
+	if expected := map[string]string{"p1": `a\tb\nc`, "p2": `['x\'y']`}; !reflect.DeepEqual(expected, db.server) {
+		t.Errorf("Expected server params are %#v, actual is %#v", expected, db.server)
+	}
+
+	_, err := NewDeleteStmt(sqb.NewStatementExecutorMock()).From("hits").Where("id", "=", Param(1)).Exec()
+	if err == nil {
+		t.Error("Exec() error is nil, expected the error about typed parameters")
+	}
+}
+
+func TestSelectStmt_TypedParameterOfUnknownType(t *testing.T) {
+	point := struct{ X, Y float64 }{1, 2}
+	st := NewSelectStmt(&typedParametersExecutorMock{}).From("hits").Where("point", "=", Param(point))
+
+	if err := st.Validate(); err == nil || !strings.Contains(err.Error(), "cannot infer ClickHouse type") {
+		t.Errorf("Validate() error = %v, expected the error about the type of the parameter", err)
+	}
+	st = NewSelectStmt(nil).From("hits").Where("point", "=", Param(point, "Tuple(Float64, Float64)"))
+	if err := st.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
@@ -17,10 +17,12 @@ type SelectStmt struct {
 	*cls.SelectClause[*SelectStmt]
 	*clickhouse.JoinClause[*SelectStmt]
 	*cls.WhereClause[*SelectStmt]
-	*cls.GroupClause[*SelectStmt]
+	*clickhouse.GroupClause[*SelectStmt]
 	*cls.HavingClause[*SelectStmt]
-	*cls.OrderClause[*SelectStmt]
-	*cls.LimitClause[*SelectStmt]
+	*clickhouse.OrderClause[*SelectStmt]
+	*clickhouse.InterpolateClause[*SelectStmt]
+	*clickhouse.LimitByClause[*SelectStmt]
+	*clickhouse.LimitClause[*SelectStmt]
 	*cls.OffsetClause[*SelectStmt]
 	*clickhouse.ApplyClause[*SelectStmt]
 	*clickhouse.ExceptClause[*SelectStmt]
@@ -32,6 +34,8 @@ type SelectStmt struct {
 	*clickhouse.QualifyClause[*SelectStmt]
 	*clickhouse.IntoOutfileClause[*SelectStmt]
 	*clickhouse.FormatClause[*SelectStmt]
+
+	executed bool
 }
 
 func NewSelectStmt(db sqb.StatementExecutor) *SelectStmt {
@@ -44,10 +48,12 @@ func NewSelectStmt(db sqb.StatementExecutor) *SelectStmt {
 	st.SelectClause = cls.NewSelectClause[*SelectStmt](st)
 	st.JoinClause = clickhouse.NewJoinClause[*SelectStmt](st)
 	st.WhereClause = cls.NewWhereClause[*SelectStmt](st)
-	st.GroupClause = cls.NewGroupClause[*SelectStmt](st)
+	st.GroupClause = clickhouse.NewGroupClause[*SelectStmt](st)
 	st.HavingClause = cls.NewHavingClause[*SelectStmt](st)
-	st.OrderClause = cls.NewOrderClause[*SelectStmt](st)
-	st.LimitClause = cls.NewLimitClause[*SelectStmt](st)
+	st.OrderClause = clickhouse.NewOrderClause[*SelectStmt](st)
+	st.InterpolateClause = clickhouse.NewInterpolateClause[*SelectStmt](st)
+	st.LimitByClause = clickhouse.NewLimitByClause[*SelectStmt](st)
+	st.LimitClause = clickhouse.NewLimitClause[*SelectStmt](st)
 	st.OffsetClause = cls.NewOffsetClause[*SelectStmt](st)
 
 	st.ApplyClause = clickhouse.NewApplyClause[*SelectStmt](st)
@@ -65,6 +71,18 @@ func NewSelectStmt(db sqb.StatementExecutor) *SelectStmt {
 
 func (s *SelectStmt) ItIsQuery() {}
 
+// ExecutedString returns the SQL sent to the statement executor, unlike the SQL of the statement itself
+// or the statement nested into another one, it has the default settings of the executor.
+func (s *SelectStmt) ExecutedString() string {
+	s.executed = true
+	s.Dirty()
+	defer func() {
+		s.executed = false
+		s.Dirty()
+	}()
+	return s.String()
+}
+
 func (s *SelectStmt) Paginate(page, size int) *SelectStmt {
 	s.Offset(size * page)
 	s.Limit(size)
@@ -128,19 +146,25 @@ func (s *SelectStmt) MustCount(column string) int64 {
 }
 
 func (s *SelectStmt) Count(column string) (int64, error) {
+	prevLimitBy := s.LimitByClause
 	prevLimit := s.LimitClause
 	prevOffset := s.OffsetClause
 	prevOrder := s.OrderClause
 	prevGroup := s.GroupClause
-	s.LimitClause = cls.NewLimitClause[*SelectStmt](s)
+	prevInterpolate := s.InterpolateClause
+	s.LimitByClause = clickhouse.NewLimitByClause[*SelectStmt](s)
+	s.LimitClause = clickhouse.NewLimitClause[*SelectStmt](s)
 	s.OffsetClause = cls.NewOffsetClause[*SelectStmt](s)
-	s.OrderClause = cls.NewOrderClause[*SelectStmt](s)
-	s.GroupClause = cls.NewGroupClause[*SelectStmt](s)
+	s.OrderClause = clickhouse.NewOrderClause[*SelectStmt](s)
+	s.GroupClause = clickhouse.NewGroupClause[*SelectStmt](s)
+	s.InterpolateClause = clickhouse.NewInterpolateClause[*SelectStmt](s)
 	result, err := s.CountWithNonConditionalClauses(column)
+	s.LimitByClause = prevLimitBy
 	s.LimitClause = prevLimit
 	s.OffsetClause = prevOffset
 	s.OrderClause = prevOrder
 	s.GroupClause = prevGroup
+	s.InterpolateClause = prevInterpolate
 	return result, err
 }
 
@@ -216,6 +240,8 @@ func (s *SelectStmt) Clean() *SelectStmt {
 	s.CleanGroup()
 	s.CleanHaving()
 	s.CleanOrder()
+	s.CleanInterpolate()
+	s.CleanLimitBy()
 	s.CleanLimit()
 	s.CleanOffset()
 
@@ -230,12 +256,12 @@ func (s *SelectStmt) Clean() *SelectStmt {
 func (s *SelectStmt) Copy() *SelectStmt {
 	st := &SelectStmt{}
 	st.WithClause = s.CopyWith(st)
-	st.ApplyClause = clickhouse.NewApplyClause[*SelectStmt](st)
-	st.ExceptClause = clickhouse.NewExceptClause[*SelectStmt](st)
-	st.IntersectClause = clickhouse.NewIntersectClause[*SelectStmt](st)
-	st.PrewhereClause = clickhouse.NewPrewhereClause[*SelectStmt](st)
-	st.SettingsClause = clickhouse.NewSettingsClause[*SelectStmt](st)
-	st.JoinClause = clickhouse.NewJoinClause[*SelectStmt](st)
+	st.ApplyClause = s.CopyApply(st)
+	st.ExceptClause = s.CopyExcept(st)
+	st.IntersectClause = s.CopyIntersect(st)
+	st.PrewhereClause = s.CopyPrewhere(st)
+	st.SettingsClause = s.CopySettings(st)
+	st.JoinClause = s.CopyJoin(st)
 	st.FromClause = s.CopyFrom(st)
 	st.SelectClause = s.CopySelect(st)
 
@@ -243,9 +269,17 @@ func (s *SelectStmt) Copy() *SelectStmt {
 	st.GroupClause = s.CopyGroup(st)
 	st.HavingClause = s.CopyHaving(st)
 	st.OrderClause = s.CopyOrder(st)
+	st.InterpolateClause = s.CopyInterpolate(st)
+	st.LimitByClause = s.CopyLimitBy(st)
 	st.LimitClause = s.CopyLimit(st)
 	st.OffsetClause = s.CopyOffset(st)
 
+	st.SampleClause = s.CopySample(st)
+	st.ReplaceClause = s.CopyReplace(st)
+	st.QualifyClause = s.CopyQualify(st)
+	st.IntoOutfileClause = s.CopyIntoOutfile(st)
+	st.FormatClause = s.CopyFormat(st)
+
 	st.DataFetching = execution.NewDataFetching[*SelectStmt](st)
 	st.BaseStatement = sql.NewBaseStatement[*SelectStmt](st, s.Executor())
 	st.UnionClause = clickhouse.NewUnionClause[*SelectStmt](st)
@@ -261,8 +295,11 @@ func (s *SelectStmt) Build() *SelectStmt {
 	if s.IsUnion() {
 		s.BuildUnion()
 		s.BuildOrder()
+		s.BuildInterpolate()
+		s.BuildLimitBy()
 		s.BuildLimit()
 		s.BuildOffset()
+		s.BuildWithTies()
 	} else {
 		s.BuildWith()
 		s.BuildSelect()
@@ -273,19 +310,30 @@ func (s *SelectStmt) Build() *SelectStmt {
 		s.BuildJoin()
 		s.BuildPrewhere()
 		s.BuildWhere()
-		s.BuildSettings()
 		s.BuildGroup()
 		s.BuildHaving()
 		s.BuildQualify()
 		s.BuildOrder()
+		s.BuildInterpolate()
+		s.BuildLimitBy()
 		s.BuildLimit()
 		s.BuildSample()
 		s.BuildOffset()
+		s.BuildWithTies()
+		if s.executed {
+			s.BuildSettingsWithDefaults()
+		} else {
+			s.BuildSettings()
+		}
 		s.BuildIntersect()
 		s.BuildIntoOutfile()
 		s.BuildFormat()
 
 	}
+	if err := s.ValidateWithTies(s.HasOrder()); err != nil {
+		s.Fail(err)
+	}
+	bindParameters(s, s.BaseStatement.Expression.Params())
 	s.Built()
 	return s
 }
@@ -3,8 +3,10 @@ package clickhouse
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
 	sql "github.com/AlephTav/sqb/sql/expression"
 )
 
@@ -179,6 +181,68 @@ func TestSelectStmt_SelectExceptWithMultipleColumns(t *testing.T) {
 
 //region FROM
 
+func TestSelectStmt_FromTableFunctions(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From(clickhouse.Numbers(10), "n").
+		From(clickhouse.File("x.csv", "CSV", "a UInt8, b String"), "f").
+		From(clickhouse.Url("https://example.com/data.tsv", "TSV", "")).
+		From(clickhouse.S3("https://bucket.s3.amazonaws.com/data.csv.gz", "", "", "gzip"))
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM numbers(:p1) n, file(:p2, CSV, 'a UInt8, b String') f, url(:p3, TSV), "+
+			"s3(:p4, auto, 'auto', 'gzip')",
+		st.String(),
+	)
+	sqb.CheckParams(
+		t,
+		map[string]any{
+			"p1": 10,
+			"p2": "x.csv",
+			"p3": "https://example.com/data.tsv",
+			"p4": "https://bucket.s3.amazonaws.com/data.csv.gz",
+		},
+		st.Params(),
+	)
+}
+
+func TestSelectStmt_FromS3WithCredentials(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From(clickhouse.S3WithCredentials("https://bucket.s3.amazonaws.com/*.parquet", "key", "secret", "Parquet", ""))
+
+	sqb.CheckSql(t, "SELECT * FROM s3(:p1, :p2, :p3, Parquet)", st.String())
+	sqb.CheckParams(
+		t,
+		map[string]any{"p1": "https://bucket.s3.amazonaws.com/*.parquet", "p2": "key", "p3": "secret"},
+		st.Params(),
+	)
+}
+
+func TestSelectStmt_JoinRemoteTableFunction(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From("events", "e").
+		GlobalLeftJoin(clickhouse.Remote("replica-{1,2}:9000", "db.users", "reader"), "u", "u.id = e.user_id")
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM events e GLOBAL LEFT JOIN remote(:p1, db.users, :p2) u ON u.id = e.user_id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "replica-{1,2}:9000", "p2": "reader"}, st.Params())
+}
+
+func TestSelectStmt_FromInputTableFunction(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select("lower(col1), col2 * 2").
+		From(clickhouse.Input("col1 String, col2 UInt8"))
+
+	sqb.CheckSql(t, "SELECT lower(col1), col2 * 2 FROM input('col1 String, col2 UInt8')", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
 func TestSelectStmt_FromTable(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("tb")
@@ -427,6 +491,53 @@ func TestSelectStmt_GroupByCube(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_GroupByRollupFunction(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select("year, month, COUNT(*)").
+		Select(sql.Grouping("year", "month"), "g").
+		From("t").
+		Rollup("year", "month")
+
+	sqb.CheckSql(t, "SELECT year, month, COUNT(*), GROUPING(year, month) g FROM t GROUP BY ROLLUP(year, month)", st.String())
+}
+
+func TestSelectStmt_GroupByGroupingSets(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select("year, month, COUNT(*)").
+		From("t").
+		GroupingSets([]any{"year", "month"}, "year", nil)
+
+	sqb.CheckSql(t, "SELECT year, month, COUNT(*) FROM t GROUP BY GROUPING SETS ((year, month), (year), ())", st.String())
+}
+
+func TestSelectStmt_GroupByWithModifiers(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select("year, month, COUNT(*)").
+		From("t").
+		GroupBy("year").
+		GroupBy("month").
+		WithTotals().
+		WithRollup()
+
+	sqb.CheckSql(t, "SELECT year, month, COUNT(*) FROM t GROUP BY year, month WITH ROLLUP WITH TOTALS", st.String())
+
+	copied := st.Copy().WithCube()
+
+	sqb.CheckSql(t, "SELECT year, month, COUNT(*) FROM t GROUP BY year, month WITH ROLLUP WITH TOTALS", st.String())
+	sqb.CheckSql(t, "SELECT year, month, COUNT(*) FROM t GROUP BY year, month WITH CUBE WITH TOTALS", copied.String())
+	sqb.CheckSql(t, "SELECT year, month, COUNT(*) FROM t", copied.CleanGroup().String())
+}
+
+func TestSelectStmt_GroupModifiersWithoutGroupBy(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select("COUNT(*)").
+		From("t").
+		WithRollup().
+		WithTotals()
+
+	sqb.CheckSql(t, "SELECT COUNT(*) FROM t", st.String())
+}
+
 func TestSelectStmt_GroupByAll(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("tb").
@@ -640,6 +751,52 @@ func TestSelectStmt_SelectIntersectWithOffset(t *testing.T) {
 
 //region JOIN
 
+func TestSelectStmt_JoinOfGlobalLeftAny(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("events_distributed", "e").
+		JoinOf(
+			clickhouse.NewJoinKind().Global().Left().Any(),
+			"users_distributed",
+			"u",
+			"u.id = e.user_id",
+		)
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM events_distributed e GLOBAL LEFT ANY JOIN users_distributed u ON u.id = e.user_id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_JoinOfLocalityAndStrictnessOnly(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("t1").
+		JoinOf(clickhouse.NewJoinKind().Global().All(), "t2", "t1.id = t2.id").
+		JoinOf(clickhouse.NewJoinKind().Local().Full().Outer(), "t3", "t1.id = t3.id")
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM t1 GLOBAL ALL JOIN t2 ON t1.id = t2.id LOCAL FULL OUTER JOIN t3 ON t1.id = t3.id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_GlobalInnerAndLeftJoin(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("t1").
+		GlobalInnerJoin("t2", "t1.id = t2.id").
+		GlobalLeftJoin(NewSelectStmt(nil).From("t3"), "a3", "t1.id = a3.id")
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM t1 GLOBAL INNER JOIN t2 ON t1.id = t2.id GLOBAL LEFT JOIN (SELECT * FROM t3) a3 ON t1.id = a3.id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
 func TestSelectStmt_LeftSemiJoin(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("t1").
@@ -885,6 +1042,29 @@ func TestSelectStmt_SelectWithTies(t *testing.T) {
 	sqb.CheckParams(t, map[string]interface{}{}, st.Params())
 }
 
+func TestSelectStmt_SelectOffsetWithTies(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("test_fetch").
+		OrderBy("a").
+		Limit(5).
+		Offset(2).
+		WithTies()
+	sqb.CheckSql(t, "SELECT * FROM test_fetch ORDER BY a LIMIT 5 OFFSET 2 WITH TIES", st.String())
+	if err := st.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSelectStmt_SelectWithTiesWithoutOrder(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("test_fetch").
+		Limit(3).
+		WithTies()
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about WITH TIES without ORDER BY")
+	}
+}
+
 // region ORDER BY
 func TestSelectStmt_OrderByColumn(t *testing.T) {
 	st := NewSelectStmt(nil).
@@ -985,14 +1165,54 @@ func TestSelectStmt_OrderByWithFill(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_OrderByWithFillOptions(t *testing.T) {
+	sqb.ResetParameterIndex()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	st := NewSelectStmt(nil).
+		Select("ts, v").
+		From("metrics").
+		OrderByWithFill("ts", clickhouse.Fill().From(from).To(to).Step(time.Hour)).
+		OrderByWithFill("n", clickhouse.Fill().Step(sql.NewExp("INTERVAL 1 MONTH")).Staleness(3), "DESC").
+		OrderByWithFill("k", nil).
+		Interpolate("v").
+		Interpolate("w", "w + 1")
+
+	sqb.CheckSql(
+		t,
+		"SELECT ts, v FROM metrics ORDER BY ts WITH FILL FROM :p1 TO :p2 STEP INTERVAL 1 HOUR, "+
+			"n DESC WITH FILL STEP INTERVAL 1 MONTH STALENESS :p3, k WITH FILL INTERPOLATE (v, w AS w + 1)",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": from, "p2": to, "p3": 3}, st.Params())
+}
+
+func TestSelectStmt_InterpolateAll(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From("metrics").
+		OrderByWithFill("n", clickhouse.Fill().From(1).To(10).Step(90*time.Second)).
+		Interpolate().
+		Limit(5)
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM metrics ORDER BY n WITH FILL FROM :p1 TO :p2 STEP INTERVAL 90 SECOND INTERPOLATE LIMIT 5",
+		st.String(),
+	)
+
+	copied := st.Copy().CleanInterpolate()
+	sqb.CheckSql(t, "SELECT * FROM metrics ORDER BY n WITH FILL FROM :p1 TO :p2 STEP INTERVAL 90 SECOND LIMIT 5", copied.String())
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": 10}, copied.Params())
+}
+
 // region LIMIT BY
 
 func TestSelectStmt_LimitBy(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("limit_by").
 		OrderBy("id, val").
-		Limit(1).
-		By("id")
+		LimitBy(1, "id")
 
 	sqb.CheckSql(
 		t,
@@ -1002,6 +1222,34 @@ func TestSelectStmt_LimitBy(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_LimitByWithOffsetAndLimit(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("limit_by").
+		OrderBy("id, val").
+		LimitBy(2, "id", sql.NewExp("domain(url)")).
+		LimitByOffset(1).
+		Limit(100).
+		Offset(10)
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM limit_by ORDER BY id, val LIMIT 2 OFFSET 1 BY id, domain(url) LIMIT 100 OFFSET 10",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_CleanLimitBy(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("limit_by").
+		LimitBy(2, "id").
+		LimitByOffset(1).
+		CleanLimitBy()
+
+	sqb.CheckSql(t, "SELECT * FROM limit_by", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
 //region PREWHERE
 
 func TestSelectStmt_SelectPrewhere(t *testing.T) {
@@ -1042,6 +1290,25 @@ func TestSelectStmt_SelectSampleWithOffset(t *testing.T) {
 
 //region WHERE
 
+func TestSelectStmt_WhereGlobalInSubquery(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From("hits_distributed").
+		Where(sql.NewCondExp().GlobalIn(
+			"user_id",
+			NewSelectStmt(nil).Select("user_id").From("users_distributed").Where("country", "=", "NL"),
+		)).
+		AndWhere(sql.EmptyCondExp().GlobalNotIn("domain", []any{"a.com", "b.com"}))
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM hits_distributed WHERE (user_id GLOBAL IN (SELECT user_id FROM users_distributed WHERE country = :p1)) "+
+			"AND (domain GLOBAL NOT IN (:p2, :p3))",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "NL", "p2": "a.com", "p3": "b.com"}, st.Params())
+}
+
 func TestSelectStmt_WhereAsString(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("tb").
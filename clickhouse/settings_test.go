@@ -0,0 +1,204 @@
+package clickhouse
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/AlephTav/sqb"
+	clickhouse "github.com/AlephTav/sqb/clickhouse/clause"
+)
+
+type settingsExecutorMock struct {
+	sqb.StatementExecutorMock
+	settings []clickhouse.Setting
+	sql      []string
+}
+
+func (m *settingsExecutorMock) DefaultSettings() []clickhouse.Setting {
+	return m.settings
+}
+
+func (m *settingsExecutorMock) Rows(sql string, params map[string]any) ([]map[string]any, error) {
+	m.sql = append(m.sql, sql)
+	return m.StatementExecutorMock.Rows(sql, params)
+}
+
+func (m *settingsExecutorMock) Exec(sql string, params map[string]any) (int64, error) {
+	m.sql = append(m.sql, sql)
+	return m.StatementExecutorMock.Exec(sql, params)
+}
+
+func (m *settingsExecutorMock) Insert(sql string, params map[string]any, sequence string) (any, error) {
+	m.sql = append(m.sql, sql)
+	return m.StatementExecutorMock.Insert(sql, params, sequence)
+}
+
+func TestSettings_Catalogue(t *testing.T) {
+	tests := []struct {
+		setting  clickhouse.Setting
+		expected string
+	}{
+		{clickhouse.AsyncInsert(true), "async_insert = 1"},
+		{clickhouse.WaitForAsyncInsert(false), "wait_for_async_insert = 0"},
+		{clickhouse.MaxThreads(8), "max_threads = 8"},
+		{clickhouse.MaxExecutionTime(90 * time.Second), "max_execution_time = 90"},
+		{clickhouse.MaxExecutionTime(1500 * time.Millisecond), "max_execution_time = 1.5"},
+		{clickhouse.MaxMemoryUsage(10_000_000_000), "max_memory_usage = 10000000000"},
+		{clickhouse.JoinUseNulls(true), "join_use_nulls = 1"},
+		{clickhouse.InsertDeduplicationToken("batch-'1'"), `insert_deduplication_token = 'batch-\'1\''`},
+		{clickhouse.Final(true), "final = 1"},
+	}
+	for _, test := range tests {
+		if test.setting.String() != test.expected {
+			t.Errorf("Expected setting is %q, actual is %q", test.expected, test.setting.String())
+		}
+	}
+}
+
+func TestSettings_NewSetting(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"async_insert", 1, "async_insert = 1"},
+		{"max_threads", uint8(4), "max_threads = 4"},
+		{"max_execution_time", 2.5, "max_execution_time = 2.5"},
+		{"optimize_on_insert", false, "optimize_on_insert = 0"},
+		{"log_comment", "import", "log_comment = 'import'"},
+	}
+	for _, test := range tests {
+		setting, err := clickhouse.NewSetting(test.name, test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if setting.String() != test.expected {
+			t.Errorf("Expected setting is %q, actual is %q", test.expected, setting.String())
+		}
+	}
+}
+
+func TestSettings_NewSettingErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{"async_insert", "yes", "setting async_insert expects boolean value, string given"},
+		{"async_insert", 2, "setting async_insert expects boolean value, int given"},
+		{"max_threads", -1, "setting max_threads expects non-negative integer value, int given"},
+		{"max_execution_time", -time.Second, "setting max_execution_time expects non-negative duration value, time.Duration given"},
+		{"insert_deduplication_token", 1, "setting insert_deduplication_token expects string value, int given"},
+		{"mutations_sync", uint(5), "setting mutations_sync expects integer from 0 to 2 value, uint given"},
+		{"log_comment", []int{1}, "setting log_comment: value of type []int is not supported"},
+		{"log_comment", nil, "setting log_comment: value cannot be nil"},
+	}
+	for _, test := range tests {
+		_, err := clickhouse.NewSetting(test.name, test.value)
+		if err == nil || err.Error() != test.expected {
+			t.Errorf("Expected error is %q, actual is %v", test.expected, err)
+		}
+	}
+}
+
+func TestSettings_SetTypedSettings(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewInsertStmt(nil).
+		Into("events").
+		Settings("input_format_allow_errors_num = 10").
+		Set(clickhouse.AsyncInsert(true), clickhouse.WaitForAsyncInsert(false)).
+		Set(clickhouse.InsertDeduplicationToken("batch-1")).
+		Values([]map[string]any{{"id": 1}})
+
+	sqb.CheckSql(
+		t,
+		"INSERT INTO events (id) SETTINGS input_format_allow_errors_num = 10, async_insert = 1, "+
+			"wait_for_async_insert = 0, insert_deduplication_token = 'batch-1' VALUES (:p1)",
+		st.String(),
+	)
+}
+
+func TestSettings_MutationsSyncOutOfRange(t *testing.T) {
+	for _, level := range []int{-1, 3} {
+		st := NewAlterDeleteStmt(sqb.NewStatementExecutorMock()).Table("t1").Where("x = 1").MutationsSync(level)
+
+		expected := "setting mutations_sync expects integer from 0 to 2 value, int given"
+		if _, err := st.Exec(); err == nil || err.Error() != expected {
+			t.Errorf("Exec() error = %v, expected %q", err, expected)
+		}
+		if err := st.CleanSettings().MutationsSync(0).Validate(); err != nil {
+			t.Errorf("Validate() error = %v", err)
+		}
+	}
+}
+
+func TestSettings_ExecutorDefaults(t *testing.T) {
+	sqb.ResetParameterIndex()
+	db := &settingsExecutorMock{settings: []clickhouse.Setting{
+		clickhouse.MaxThreads(4),
+		clickhouse.MaxExecutionTime(time.Minute),
+		clickhouse.JoinUseNulls(true),
+	}}
+
+	st := NewSelectStmt(db).
+		From(NewSelectStmt(db).From("t2"), "t").
+		Where("x > 1").
+		Set(clickhouse.MaxThreads(16)).
+		Settings("join_use_nulls=0")
+	if _, err := st.Rows(); err != nil {
+		t.Fatal(err)
+	}
+	sqb.CheckSql(t, "SELECT * FROM (SELECT * FROM t2) t WHERE x > 1 SETTINGS max_threads = 16, join_use_nulls=0", st.String())
+
+	if _, err := NewInsertStmt(db).Into("t1").Values(map[string]any{"a": 1}).Exec(""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewAlterDeleteStmt(db).Table("t1").Where("x = 1").MutationsSync(2).Exec(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCreateTableStmt(db).Table("t1").Column("a", "UInt8").Engine(clickhouse.MergeTree()).Exec(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"SELECT * FROM (SELECT * FROM t2) t WHERE x > 1 SETTINGS max_threads = 16, join_use_nulls=0, " +
+			"max_execution_time = 60",
+		"INSERT INTO t1 (a) SETTINGS max_threads = 4, max_execution_time = 60, join_use_nulls = 1 VALUES (:p1)",
+		"ALTER TABLE t1 DELETE WHERE x = 1 SETTINGS mutations_sync = 2",
+		"CREATE TABLE t1 (a UInt8) ENGINE = MergeTree",
+	}
+	if !reflect.DeepEqual(expected, db.sql) {
+		t.Errorf("Expected executed SQL is %q, actual is %q", expected, db.sql)
+	}
+}
+
+func TestSettings_ExecutorDefaultsAfterLimit(t *testing.T) {
+	db := &settingsExecutorMock{settings: []clickhouse.Setting{clickhouse.MaxThreads(4)}}
+	st := NewSelectStmt(db).
+		Select("user_id, count() AS hits").
+		From("hits").
+		Where("x > 1").
+		GroupBy("user_id").
+		OrderBy("hits", "DESC").
+		Limit(10).
+		Offset(20).
+		Format("JSONEachRow")
+	if _, err := st.Rows(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"SELECT user_id, count() AS hits FROM hits WHERE x > 1 GROUP BY user_id ORDER BY hits DESC LIMIT 10 OFFSET 20 " +
+			"SETTINGS max_threads = 4 FORMAT JSONEachRow",
+	}
+	if !reflect.DeepEqual(expected, db.sql) {
+		t.Errorf("Expected executed SQL is %q, actual is %q", expected, db.sql)
+	}
+	sqb.CheckSql(
+		t,
+		"SELECT user_id, count() AS hits FROM hits WHERE x > 1 GROUP BY user_id ORDER BY hits DESC LIMIT 10 OFFSET 20 "+
+			"FORMAT JSONEachRow",
+		st.String(),
+	)
+}
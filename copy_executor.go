@@ -0,0 +1,46 @@
+package sqb
+
+import "io"
+
+// RowSource is an iterator over the rows streamed to the database.
+type RowSource interface {
+	// Next advances to the next row and returns false when there are no more rows or an error occurred.
+	Next() bool
+	// Values returns the values of the current row.
+	Values() ([]any, error)
+	// Err returns the error that stopped the iteration.
+	Err() error
+}
+
+// CopyExecutor is the optional capability of a StatementExecutor to stream data of COPY statements.
+type CopyExecutor interface {
+	// CopyFrom executes COPY ... FROM STDIN reading the data in the statement format from the reader.
+	CopyFrom(sql string, source io.Reader) (int64, error)
+	// CopyFromRows executes COPY ... FROM STDIN encoding the rows of the source.
+	CopyFromRows(sql string, source RowSource) (int64, error)
+	// CopyTo executes COPY ... TO STDOUT writing the data in the statement format to the writer.
+	CopyTo(sql string, target io.Writer) (int64, error)
+}
+
+type sliceRowSource struct {
+	rows  [][]any
+	index int
+}
+
+// RowsFromSlice returns the row source iterating over the given rows.
+func RowsFromSlice(rows [][]any) RowSource {
+	return &sliceRowSource{rows, -1}
+}
+
+func (s *sliceRowSource) Next() bool {
+	s.index++
+	return s.index < len(s.rows)
+}
+
+func (s *sliceRowSource) Values() ([]any, error) {
+	return s.rows[s.index], nil
+}
+
+func (s *sliceRowSource) Err() error {
+	return nil
+}
@@ -0,0 +1,35 @@
+package execution
+
+import (
+	"errors"
+	"io"
+
+	"github.com/AlephTav/sqb"
+)
+
+type BodyExecution[T sqb.Statement[T]] struct {
+	self T
+}
+
+func NewBodyExecution[T sqb.Statement[T]](self T) *BodyExecution[T] {
+	return &BodyExecution[T]{self}
+}
+
+func (b *BodyExecution[T]) MustExecWithBody(body io.Reader) int64 {
+	r, err := b.ExecWithBody(body)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (b *BodyExecution[T]) ExecWithBody(body io.Reader) (int64, error) {
+	if err := validate(b.self); err != nil {
+		return 0, err
+	}
+	executor, ok := b.self.Executor().(sqb.BodyExecutor)
+	if !ok {
+		return 0, errors.New("statement executor does not support sending of statement body")
+	}
+	return executor.ExecWithBody(executedSql(b.self), b.self.Params(), body)
+}
@@ -0,0 +1,183 @@
+package execution
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/AlephTav/sqb"
+)
+
+// BulkInsertStmt is the insert statement whose values can be split into several statements.
+type BulkInsertStmt[T any] interface {
+	sqb.Statement[T]
+	Values(values any, args ...any) T
+}
+
+// BulkResult is the aggregated result of all statements of the bulk insert.
+type BulkResult struct {
+	Affected int64
+	Rows     []map[string]any
+}
+
+// BulkInsert splits the rows into chunks and inserts every chunk with a copy of the statement.
+// The statement must have no values and columns, they are set from the keys of the rows.
+// All rows must have the same keys, so a missing key is not inserted as NULL instead of the column default.
+type BulkInsert[T BulkInsertStmt[T]] struct {
+	stmt        T
+	rows        []map[string]any
+	maxParams   int
+	maxRows     int
+	transaction bool
+}
+
+func NewBulkInsert[T BulkInsertStmt[T]](stmt T, rows []map[string]any) *BulkInsert[T] {
+	return &BulkInsert[T]{stmt: stmt, rows: rows}
+}
+
+// MaxParams limits the number of parameters of every statement, zero means no limit.
+func (b *BulkInsert[T]) MaxParams(maxParams int) *BulkInsert[T] {
+	b.maxParams = maxParams
+	return b
+}
+
+// MaxRows limits the number of rows of every statement, zero means no limit.
+func (b *BulkInsert[T]) MaxRows(maxRows int) *BulkInsert[T] {
+	b.maxRows = maxRows
+	return b
+}
+
+// InTransaction makes the statements be executed in a transaction,
+// the statement executor must implement sqb.TransactionExecutor.
+func (b *BulkInsert[T]) InTransaction() *BulkInsert[T] {
+	b.transaction = true
+	return b
+}
+
+// Statements returns the insert statements of all chunks of rows.
+func (b *BulkInsert[T]) Statements() ([]T, error) {
+	if len(b.rows) == 0 {
+		return nil, nil
+	}
+	columns, err := b.columns()
+	if err != nil {
+		return nil, err
+	}
+	size, err := b.chunkSize(len(columns))
+	if err != nil {
+		return nil, err
+	}
+	statements := make([]T, 0, (len(b.rows)+size-1)/size)
+	for start := 0; start < len(b.rows); start += size {
+		end := min(start+size, len(b.rows))
+		values := make([]any, 0, end-start)
+		for _, row := range b.rows[start:end] {
+			values = append(values, b.sliceMap(row, columns))
+		}
+		statements = append(statements, b.stmt.Copy().Values(values))
+	}
+	return statements, nil
+}
+
+func (b *BulkInsert[T]) MustExec() BulkResult {
+	r, err := b.Exec()
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Exec executes the statements sequentially, the result contains the affected rows of the executed statements
+// and the returned rows if the statement has the RETURNING clause.
+func (b *BulkInsert[T]) Exec() (BulkResult, error) {
+	var result BulkResult
+	statements, err := b.Statements()
+	if err != nil {
+		return result, err
+	}
+	if !b.transaction {
+		err = b.exec(b.stmt.Executor(), statements, &result)
+		return result, err
+	}
+	executor, ok := b.stmt.Executor().(sqb.TransactionExecutor)
+	if !ok {
+		return result, errors.New("statement executor does not support transactions")
+	}
+	err = executor.Transaction(func(db sqb.StatementExecutor) error {
+		result = BulkResult{}
+		return b.exec(db, statements, &result)
+	})
+	return result, err
+}
+
+func (b *BulkInsert[T]) exec(db sqb.StatementExecutor, statements []T, result *BulkResult) error {
+	returning := b.hasReturning()
+	for i, statement := range statements {
+		if err := validate(statement); err != nil {
+			return fmt.Errorf("bulk insert chunk %d of %d: %w", i+1, len(statements), err)
+		}
+		if returning {
+			rows, err := db.Rows(executedSql(statement), statement.Params())
+			if err != nil {
+				return fmt.Errorf("bulk insert chunk %d of %d: %w", i+1, len(statements), err)
+			}
+			result.Rows = append(result.Rows, rows...)
+			result.Affected += int64(len(rows))
+		} else {
+			n, err := db.Exec(executedSql(statement), statement.Params())
+			if err != nil {
+				return fmt.Errorf("bulk insert chunk %d of %d: %w", i+1, len(statements), err)
+			}
+			result.Affected += n
+		}
+	}
+	return nil
+}
+
+func (b *BulkInsert[T]) hasReturning() bool {
+	r, ok := any(b.stmt).(interface{ HasReturning() bool })
+	return ok && r.HasReturning()
+}
+
+// columns returns the sorted keys of the rows, it fails if the rows have different keys.
+func (b *BulkInsert[T]) columns() ([]string, error) {
+	columns := make([]string, 0, len(b.rows[0]))
+	for column := range b.rows[0] {
+		columns = append(columns, column)
+	}
+	slices.Sort(columns)
+	for i, row := range b.rows[1:] {
+		if len(row) != len(columns) {
+			return nil, fmt.Errorf("bulk insert row %d has %d columns, expected columns are %v", i+2, len(row), columns)
+		}
+		for _, column := range columns {
+			if _, ok := row[column]; !ok {
+				return nil, fmt.Errorf("bulk insert row %d has no column %q, expected columns are %v", i+2, column, columns)
+			}
+		}
+	}
+	return columns, nil
+}
+
+func (b *BulkInsert[T]) chunkSize(columnCount int) (int, error) {
+	size := len(b.rows)
+	if b.maxRows > 0 {
+		size = min(size, b.maxRows)
+	}
+	if b.maxParams > 0 && columnCount > 0 {
+		available := b.maxParams - len(b.stmt.Copy().Params())
+		if available < columnCount {
+			return 0, fmt.Errorf("a row of %d columns exceeds the limit of %d parameters", columnCount, b.maxParams)
+		}
+		size = min(size, available/columnCount)
+	}
+	return size, nil
+}
+
+func (b *BulkInsert[T]) sliceMap(row map[string]any, columns []string) sqb.SliceMap {
+	values := make(sqb.SliceMap, 0, 2*len(columns))
+	for _, column := range columns {
+		values = append(values, column, row[column])
+	}
+	return values
+}
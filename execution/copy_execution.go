@@ -0,0 +1,78 @@
+package execution
+
+import (
+	"errors"
+	"io"
+
+	"github.com/AlephTav/sqb"
+)
+
+type CopyExecution[T sqb.Statement[T]] struct {
+	self T
+}
+
+func NewCopyExecution[T sqb.Statement[T]](self T) *CopyExecution[T] {
+	return &CopyExecution[T]{self}
+}
+
+func (c *CopyExecution[T]) MustCopyFrom(source io.Reader) int64 {
+	r, err := c.CopyFrom(source)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (c *CopyExecution[T]) CopyFrom(source io.Reader) (int64, error) {
+	executor, err := c.executor()
+	if err != nil {
+		return 0, err
+	}
+	return executor.CopyFrom(executedSql(c.self), source)
+}
+
+func (c *CopyExecution[T]) MustCopyFromRows(source sqb.RowSource) int64 {
+	r, err := c.CopyFromRows(source)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (c *CopyExecution[T]) CopyFromRows(source sqb.RowSource) (int64, error) {
+	executor, err := c.executor()
+	if err != nil {
+		return 0, err
+	}
+	return executor.CopyFromRows(executedSql(c.self), source)
+}
+
+func (c *CopyExecution[T]) MustCopyTo(target io.Writer) int64 {
+	r, err := c.CopyTo(target)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (c *CopyExecution[T]) CopyTo(target io.Writer) (int64, error) {
+	executor, err := c.executor()
+	if err != nil {
+		return 0, err
+	}
+	return executor.CopyTo(executedSql(c.self), target)
+}
+
+func (c *CopyExecution[T]) executor() (sqb.CopyExecutor, error) {
+	if err := validate(c.self); err != nil {
+		return nil, err
+	}
+	executor, ok := c.self.Executor().(sqb.CopyExecutor)
+	if !ok {
+		return nil, errors.New("statement executor does not support streaming of COPY data")
+	}
+	if len(c.self.Params()) > 0 {
+		return nil, errors.New("COPY statement cannot have parameters")
+	}
+	return executor, nil
+}
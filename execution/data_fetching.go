@@ -123,33 +123,74 @@ func (d *DataFetching[T]) rowsByGroup(
 }
 
 func (d *DataFetching[T]) MustRows() []map[string]any {
-	return d.self.Executor().MustRows(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		panic(err)
+	}
+	return d.self.Executor().MustRows(executedSql(d.self), d.self.Params())
 }
 
 func (d *DataFetching[T]) Rows() ([]map[string]any, error) {
-	return d.self.Executor().Rows(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		return nil, err
+	}
+	return d.self.Executor().Rows(executedSql(d.self), d.self.Params())
 }
 
 func (d *DataFetching[T]) MustRow() map[string]any {
-	return d.self.Executor().MustRow(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		panic(err)
+	}
+	return d.self.Executor().MustRow(executedSql(d.self), d.self.Params())
 }
 
 func (d *DataFetching[T]) Row() (map[string]any, error) {
-	return d.self.Executor().Row(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		return nil, err
+	}
+	return d.self.Executor().Row(executedSql(d.self), d.self.Params())
 }
 
 func (d *DataFetching[T]) MustColumn() []any {
-	return d.self.Executor().MustColumn(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		panic(err)
+	}
+	return d.self.Executor().MustColumn(executedSql(d.self), d.self.Params())
 }
 
 func (d *DataFetching[T]) Column() ([]any, error) {
-	return d.self.Executor().Column(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		return nil, err
+	}
+	return d.self.Executor().Column(executedSql(d.self), d.self.Params())
 }
 
 func (d *DataFetching[T]) MustOne() any {
-	return d.self.Executor().MustOne(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		panic(err)
+	}
+	return d.self.Executor().MustOne(executedSql(d.self), d.self.Params())
 }
 
 func (d *DataFetching[T]) One() (any, error) {
-	return d.self.Executor().One(d.self.String(), d.self.Params())
+	if err := validate(d.self); err != nil {
+		return nil, err
+	}
+	return d.self.Executor().One(executedSql(d.self), d.self.Params())
+}
+
+// validate returns the error of the statement that can check its consistency before execution.
+func validate(statement any) error {
+	if v, ok := statement.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// executedSql returns the SQL sent to the executor, the statement can render it differently from its SQL
+// as a nested statement, e.g. with the default settings of the executor.
+func executedSql(statement interface{ String() string }) string {
+	if e, ok := statement.(interface{ ExecutedString() string }); ok {
+		return e.ExecutedString()
+	}
+	return statement.String()
 }
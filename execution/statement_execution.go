@@ -11,9 +11,15 @@ func NewStatementExecution[T sqb.Statement[T]](self T) *StatementExecution[T] {
 }
 
 func (s *StatementExecution[T]) MustExec() int64 {
-	return s.self.Executor().MustExec(s.self.String(), s.self.Params())
+	if err := validate(s.self); err != nil {
+		panic(err)
+	}
+	return s.self.Executor().MustExec(executedSql(s.self), s.self.Params())
 }
 
 func (s *StatementExecution[T]) Exec() (int64, error) {
-	return s.self.Executor().Exec(s.self.String(), s.self.Params())
+	if err := validate(s.self); err != nil {
+		return 0, err
+	}
+	return s.self.Executor().Exec(executedSql(s.self), s.self.Params())
 }
@@ -0,0 +1,56 @@
+package filter
+
+import (
+	"net/url"
+
+	"github.com/AlephTav/sqb"
+)
+
+type FilterableStmt[T any] interface {
+	sqb.Statement[T]
+	AndWhere(args ...any) T
+}
+
+type SortableStmt[T any] interface {
+	sqb.Statement[T]
+	OrderBy(column any, args ...any) T
+}
+
+// Where adds the conditions of the filter document to the WHERE clause of the statement.
+func Where[T FilterableStmt[T]](st T, schema *Schema, doc map[string]any) (T, error) {
+	cond, err := schema.Filter(doc)
+	if err != nil {
+		return st, err
+	}
+	if cond.IsNotEmpty() {
+		st.AndWhere(cond)
+	}
+	return st, nil
+}
+
+// OrderBy adds the fields of the sort specification to the ORDER BY clause of the statement.
+func OrderBy[T SortableStmt[T]](st T, schema *Schema, spec string) (T, error) {
+	order, err := schema.Sort(spec)
+	if err != nil {
+		return st, err
+	}
+	if len(order) > 0 {
+		st.OrderBy(order)
+	}
+	return st, nil
+}
+
+// Query applies "filter[...]" and "sort" query parameters to the statement.
+func Query[T interface {
+	FilterableStmt[T]
+	SortableStmt[T]
+}](st T, schema *Schema, values url.Values) (T, error) {
+	doc, err := queryDocument(values)
+	if err != nil {
+		return st, err
+	}
+	if st, err = Where(st, schema, doc); err != nil {
+		return st, err
+	}
+	return OrderBy(st, schema, values.Get("sort"))
+}
@@ -0,0 +1,105 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Coercer converts the user supplied value to the value bound to the statement.
+type Coercer func(value any) (any, error)
+
+func AsString(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return nil, fmt.Errorf("value of type %T cannot be converted to string", value)
+	}
+}
+
+func AsInt(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q cannot be converted to integer", v)
+		}
+		return i, nil
+	case float64:
+		if v != float64(int64(v)) {
+			return nil, fmt.Errorf("value %v cannot be converted to integer", v)
+		}
+		return int64(v), nil
+	default:
+		return nil, fmt.Errorf("value of type %T cannot be converted to integer", value)
+	}
+}
+
+func AsFloat(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value %q cannot be converted to float", v)
+		}
+		return f, nil
+	case float64:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("value of type %T cannot be converted to float", value)
+	}
+}
+
+func AsBool(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("value %q cannot be converted to boolean", v)
+		}
+		return b, nil
+	case bool:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("value of type %T cannot be converted to boolean", value)
+	}
+}
+
+// AsTime returns the coercer parsing strings with the given layouts, the first matching layout wins.
+func AsTime(layouts ...string) Coercer {
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339, time.DateTime, time.DateOnly}
+	}
+	return func(value any) (any, error) {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of type %T cannot be converted to time", value)
+		}
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("value %q cannot be converted to time", s)
+	}
+}
+
+// OneOf returns the coercer accepting only the listed string values.
+func OneOf(allowed ...string) Coercer {
+	return func(value any) (any, error) {
+		s, ok := value.(string)
+		if ok {
+			for _, a := range allowed {
+				if a == s {
+					return s, nil
+				}
+			}
+		}
+		return nil, fmt.Errorf("value %v is not allowed", value)
+	}
+}
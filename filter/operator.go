@@ -0,0 +1,40 @@
+package filter
+
+type Operator string
+
+const (
+	Eq      Operator = "eq"
+	Ne      Operator = "ne"
+	Gt      Operator = "gt"
+	Gte     Operator = "gte"
+	Lt      Operator = "lt"
+	Lte     Operator = "lte"
+	In      Operator = "in"
+	Nin     Operator = "nin"
+	Like    Operator = "like"
+	ILike   Operator = "ilike"
+	Between Operator = "between"
+	IsNull  Operator = "null"
+)
+
+var operators = map[Operator]string{
+	Eq:      "=",
+	Ne:      "<>",
+	Gt:      ">",
+	Gte:     ">=",
+	Lt:      "<",
+	Lte:     "<=",
+	In:      "IN",
+	Nin:     "NOT IN",
+	Like:    "LIKE",
+	ILike:   "ILIKE",
+	Between: "BETWEEN",
+}
+
+func (o Operator) sql() string {
+	return operators[o]
+}
+
+func (o Operator) isList() bool {
+	return o == In || o == Nin || o == Between
+}
@@ -0,0 +1,228 @@
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// Column describes a filterable and/or sortable field exposed to the user.
+// Name is the SQL expression the field is mapped to, it never comes from user input.
+type Column struct {
+	Name      string
+	Operators []Operator
+	Coerce    Coercer
+	Sortable  bool
+}
+
+type Schema struct {
+	columns map[string]Column
+}
+
+func NewSchema() *Schema {
+	return &Schema{make(map[string]Column)}
+}
+
+// Column adds the allowed field to the schema:
+//   - Column(field string, column Column)
+func (s *Schema) Column(field string, column Column) *Schema {
+	if column.Name == "" {
+		column.Name = field
+	}
+	if len(column.Operators) == 0 {
+		column.Operators = []Operator{Eq}
+	}
+	s.columns[field] = column
+	return s
+}
+
+// FilterJSON decodes the filter document and translates it to the conditional expression.
+func (s *Schema) FilterJSON(data []byte) (sql.ConditionalExpression, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return sql.EmptyCondExp(), fmt.Errorf("filter document is malformed: %w", err)
+	}
+	return s.Filter(doc)
+}
+
+// FilterQuery reads the filter document from the query parameters of the form
+// "filter[field]=value" and "filter[field][operator]=value" and translates it to the conditional expression.
+// Values of the list operators are separated by commas. A field cannot be given in both forms.
+func (s *Schema) FilterQuery(values url.Values) (sql.ConditionalExpression, error) {
+	doc, err := queryDocument(values)
+	if err != nil {
+		return sql.EmptyCondExp(), err
+	}
+	return s.Filter(doc)
+}
+
+// queryDocument converts the "filter[...]" query parameters to the filter document.
+func queryDocument(values url.Values) (map[string]any, error) {
+	doc := make(map[string]any)
+	for _, key := range sortedKeys(values) {
+		vals := values[key]
+		if !strings.HasPrefix(key, "filter[") || len(vals) == 0 {
+			continue
+		}
+		parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(key, "filter["), "]"), "][")
+		field := parts[0]
+		switch len(parts) {
+		case 1:
+			if _, exists := doc[field]; exists {
+				return nil, fmt.Errorf("field %q is given both as a value and with operators", field)
+			}
+			doc[field] = vals[0]
+		case 2:
+			ops, ok := doc[field].(map[string]any)
+			if !ok {
+				if _, exists := doc[field]; exists {
+					return nil, fmt.Errorf("field %q is given both as a value and with operators", field)
+				}
+				ops = make(map[string]any)
+				doc[field] = ops
+			}
+			op := Operator(parts[1])
+			if op.isList() {
+				ops[parts[1]] = stringsToSlice(strings.Split(vals[0], ","))
+			} else {
+				ops[parts[1]] = vals[0]
+			}
+		default:
+			return nil, fmt.Errorf("filter parameter %q is malformed", key)
+		}
+	}
+	return doc, nil
+}
+
+// Filter translates the filter document to the conditional expression:
+//   - {"field": value} is the short form of {"field": {"eq": value}}
+//   - {"field": {"operator": value, ...}}
+func (s *Schema) Filter(doc map[string]any) (sql.ConditionalExpression, error) {
+	cond := sql.EmptyCondExp()
+	for _, field := range sortedKeys(doc) {
+		column, exists := s.columns[field]
+		if !exists {
+			return sql.EmptyCondExp(), fmt.Errorf("field %q is not filterable", field)
+		}
+		ops, ok := doc[field].(map[string]any)
+		if !ok {
+			ops = map[string]any{string(Eq): doc[field]}
+		}
+		for _, name := range sortedKeys(ops) {
+			if err := s.addCondition(cond, field, column, Operator(name), ops[name]); err != nil {
+				return sql.EmptyCondExp(), err
+			}
+		}
+	}
+	return cond, nil
+}
+
+func (s *Schema) addCondition(cond sql.ConditionalExpression, field string, column Column, op Operator, value any) error {
+	if !slices.Contains(column.Operators, op) {
+		return fmt.Errorf("operator %q is not allowed for field %q", op, field)
+	}
+	if op == IsNull {
+		isNull, ok := value.(bool)
+		if !ok {
+			b, err := AsBool(value)
+			if err != nil {
+				return fmt.Errorf("field %q: %w", field, err)
+			}
+			isNull = b.(bool)
+		}
+		if isNull {
+			cond.Where(column.Name + " IS NULL")
+		} else {
+			cond.Where(column.Name + " IS NOT NULL")
+		}
+		return nil
+	}
+	value, err := s.coerce(column, op, value)
+	if err != nil {
+		return fmt.Errorf("field %q: %w", field, err)
+	}
+	cond.Where(column.Name, op.sql(), value)
+	return nil
+}
+
+func (s *Schema) coerce(column Column, op Operator, value any) (any, error) {
+	if !op.isList() {
+		if _, ok := value.([]any); ok {
+			return nil, fmt.Errorf("operator %q expects a single value", op)
+		}
+		return coerceValue(column.Coerce, value)
+	}
+	values, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("operator %q expects a list of values", op)
+	}
+	if op == Between && len(values) != 2 {
+		return nil, fmt.Errorf("operator %q expects two values", op)
+	}
+	if len(values) == 0 {
+		return nil, fmt.Errorf("operator %q expects at least one value", op)
+	}
+	result := make([]any, len(values))
+	for i, v := range values {
+		var err error
+		if result[i], err = coerceValue(column.Coerce, v); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Sort translates the sort specification to the column-direction map accepted by OrderBy.
+// The specification is a comma separated list of fields, the leading "-" means descending order:
+//   - Sort("-created_at,name")
+func (s *Schema) Sort(spec string) (sqb.SliceMap, error) {
+	var order sqb.SliceMap
+	for _, field := range strings.Split(spec, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		direction := "ASC"
+		if strings.HasPrefix(field, "-") {
+			direction = "DESC"
+			field = field[1:]
+		} else {
+			field = strings.TrimPrefix(field, "+")
+		}
+		column, exists := s.columns[field]
+		if !exists || !column.Sortable {
+			return nil, fmt.Errorf("field %q is not sortable", field)
+		}
+		order = append(order, column.Name, direction)
+	}
+	return order, nil
+}
+
+func coerceValue(coerce Coercer, value any) (any, error) {
+	if value == nil {
+		return nil, fmt.Errorf("value must not be null")
+	}
+	if coerce == nil {
+		return value, nil
+	}
+	return coerce(value)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := sqb.MapKeys[string, V](m)
+	slices.Sort(keys)
+	return keys
+}
+
+func stringsToSlice(values []string) []any {
+	result := make([]any, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
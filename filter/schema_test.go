@@ -0,0 +1,129 @@
+package filter
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/postgresql"
+)
+
+func newSchema() *Schema {
+	return NewSchema().
+		Column("status", Column{Name: "u.status", Operators: []Operator{Eq, In, Nin}, Coerce: AsString}).
+		Column("age", Column{Name: "u.age", Operators: []Operator{Gt, Lte, Between}, Coerce: AsInt, Sortable: true}).
+		Column("created_at", Column{Name: "u.created_at", Operators: []Operator{Gte, IsNull}, Coerce: AsTime(), Sortable: true})
+}
+
+func TestSchema_FilterShortForm(t *testing.T) {
+	sqb.ResetParameterIndex()
+	cond, err := newSchema().Filter(map[string]any{"status": "active"})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqb.CheckSql(t, "u.status = :p1", cond.String())
+	sqb.CheckParams(t, map[string]any{"p1": "active"}, cond.Params())
+}
+
+func TestSchema_FilterJSON(t *testing.T) {
+	sqb.ResetParameterIndex()
+	cond, err := newSchema().FilterJSON([]byte(
+		`{"status":{"in":["a","b"]},"created_at":{"gte":"2024-01-01"},"age":{"between":[18,"30"]}}`,
+	))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqb.CheckSql(t, "u.age BETWEEN :p1 AND :p2 AND u.created_at >= :p3 AND u.status IN (:p4, :p5)", cond.String())
+	sqb.CheckParams(
+		t,
+		map[string]any{
+			"p1": int64(18),
+			"p2": int64(30),
+			"p3": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			"p4": "a",
+			"p5": "b",
+		},
+		cond.Params(),
+	)
+}
+
+func TestSchema_FilterIsNull(t *testing.T) {
+	cond, err := newSchema().Filter(map[string]any{"created_at": map[string]any{"null": false}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqb.CheckSql(t, "u.created_at IS NOT NULL", cond.String())
+}
+
+func TestSchema_FilterUnknownField(t *testing.T) {
+	_, err := newSchema().Filter(map[string]any{"password": "x"})
+
+	if err == nil || err.Error() != `field "password" is not filterable` {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSchema_FilterNotAllowedOperator(t *testing.T) {
+	_, err := newSchema().Filter(map[string]any{"status": map[string]any{"like": "%a%"}})
+
+	if err == nil || err.Error() != `operator "like" is not allowed for field "status"` {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSchema_FilterInvalidValue(t *testing.T) {
+	_, err := newSchema().Filter(map[string]any{"age": map[string]any{"gt": "ten"}})
+
+	if err == nil || err.Error() != `field "age": value "ten" cannot be converted to integer` {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSchema_Sort(t *testing.T) {
+	order, err := newSchema().Sort("-created_at, age")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := postgresql.NewSelectStmt(nil).From("users u").OrderBy(order)
+	sqb.CheckSql(t, "SELECT * FROM users u ORDER BY u.created_at DESC, u.age ASC", st.String())
+}
+
+func TestSchema_SortNotSortableField(t *testing.T) {
+	_, err := newSchema().Sort("status")
+
+	if err == nil || err.Error() != `field "status" is not sortable` {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestQuery(t *testing.T) {
+	sqb.ResetParameterIndex()
+	values, _ := url.ParseQuery("filter[status][nin]=a,b&filter[age][gt]=18&sort=-age&page=2")
+	st, err := Query(postgresql.NewSelectStmt(nil).From("users u").Where("u.deleted_at IS NULL"), newSchema(), values)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM users u WHERE u.deleted_at IS NULL AND (u.age > :p1 AND u.status NOT IN (:p2, :p3)) ORDER BY u.age DESC",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": int64(18), "p2": "a", "p3": "b"}, st.Params())
+}
+
+func TestSchema_FilterQueryBothForms(t *testing.T) {
+	values, _ := url.ParseQuery("filter[status]=a&filter[status][in]=b,c")
+	for i := 0; i < 10; i++ {
+		_, err := newSchema().FilterQuery(values)
+
+		if err == nil || err.Error() != `field "status" is given both as a value and with operators` {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package migration
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/AlephTav/sqb"
+	ch "github.com/AlephTav/sqb/clickhouse"
+	pg "github.com/AlephTav/sqb/postgresql"
+	pgcls "github.com/AlephTav/sqb/postgresql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+// Statement is the SQL statement executed by the migrator.
+type Statement interface {
+	String() string
+	Params() map[string]any
+}
+
+// Dialect builds the statements that maintain the table of applied migrations.
+type Dialect interface {
+	// CreateTable returns the statement creating the version table if it does not exist.
+	CreateTable(table string) Statement
+	// TableExists returns the query selecting true if the version table exists.
+	TableExists(table string) Statement
+	// Applied returns the query selecting the applied versions in the "version" column.
+	Applied(table string) Statement
+	MarkApplied(table string, migration Migration) Statement
+	MarkReverted(table string, migration Migration) Statement
+	// Lock returns the statement preventing concurrent migrations until the end of the transaction
+	// or nil if the database has no suitable lock.
+	Lock(table string) Statement
+}
+
+type postgreSQL struct{}
+
+// PostgreSQL returns the dialect keeping versions in a regular table and serializing migrations
+// with the transaction level advisory lock, so the executor must implement sqb.TransactionExecutor.
+func PostgreSQL() Dialect {
+	return postgreSQL{}
+}
+
+func (postgreSQL) CreateTable(table string) Statement {
+	return pg.NewCreateTableStmt(nil).
+		IfNotExists().
+		Table(table).
+		Column(pgcls.NewColumnDef("version", "BIGINT").PrimaryKey()).
+		Column(pgcls.NewColumnDef("name", "TEXT").NotNull()).
+		Column(pgcls.NewColumnDef("applied_at", "TIMESTAMPTZ").NotNull().Default("now()"))
+}
+
+func (postgreSQL) TableExists(table string) Statement {
+	p := sqb.NextParameterName()
+	return pg.NewSelectStmt(nil).
+		Select(exp.NewExpWithParams("to_regclass(:"+p+") IS NOT NULL", map[string]any{p: table}))
+}
+
+func (postgreSQL) Applied(table string) Statement {
+	return pg.NewSelectStmt(nil).
+		Unscoped().
+		Select("version").
+		From(table).
+		OrderBy("version")
+}
+
+func (postgreSQL) MarkApplied(table string, migration Migration) Statement {
+	return pg.NewInsertStmt(nil).
+		Into(table).
+		Values(sqb.Map("version", migration.Version, "name", migration.Name))
+}
+
+func (postgreSQL) MarkReverted(table string, migration Migration) Statement {
+	return pg.NewDeleteStmt(nil).
+		Unscoped().
+		From(table).
+		Where("version", "=", migration.Version)
+}
+
+func (postgreSQL) Lock(table string) Statement {
+	return pg.NewSelectStmt(nil).Select(fmt.Sprintf("pg_advisory_xact_lock(%d)", lockKey(table)))
+}
+
+func lockKey(table string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte("sqb.migration:" + table))
+	return int64(h.Sum64())
+}
+
+type clickHouse struct{}
+
+// ClickHouse returns the dialect keeping versions in an append-only MergeTree table,
+// reverting a migration inserts the row marking the version as not applied.
+// ClickHouse has no locks, so migrations must not be run concurrently.
+func ClickHouse() Dialect {
+	return clickHouse{}
+}
+
+func (clickHouse) CreateTable(table string) Statement {
+	return exp.NewExp("CREATE TABLE IF NOT EXISTS " + table +
+		" (version Int64, name String, applied UInt8, created_at DateTime64(6) DEFAULT now64(6))" +
+		" ENGINE = MergeTree ORDER BY (version, created_at)")
+}
+
+func (clickHouse) TableExists(table string) Statement {
+	return exp.NewExp("EXISTS TABLE " + table)
+}
+
+func (clickHouse) Applied(table string) Statement {
+	return ch.NewSelectStmt(nil).
+		Select("version").
+		From(table).
+		GroupBy("version").
+		Having("argMax(applied, created_at) = 1").
+		OrderBy("version")
+}
+
+func (c clickHouse) MarkApplied(table string, migration Migration) Statement {
+	return c.mark(table, migration, 1)
+}
+
+func (c clickHouse) MarkReverted(table string, migration Migration) Statement {
+	return c.mark(table, migration, 0)
+}
+
+func (clickHouse) mark(table string, migration Migration, applied int) Statement {
+	return ch.NewInsertStmt(nil).
+		Into(table).
+		Values(sqb.Map("version", migration.Version, "name", migration.Name, "applied", applied))
+}
+
+func (clickHouse) Lock(string) Statement {
+	return nil
+}
@@ -0,0 +1,80 @@
+package migration
+
+import (
+	"fmt"
+	"io"
+)
+
+// dryRunExecutor prints the statements instead of executing them.
+type dryRunExecutor struct {
+	w io.Writer
+}
+
+func newDryRunExecutor(w io.Writer) *dryRunExecutor {
+	return &dryRunExecutor{w}
+}
+
+func (e *dryRunExecutor) print(sql string, params map[string]any) error {
+	var err error
+	if len(params) > 0 {
+		_, err = fmt.Fprintf(e.w, "%s; -- %v\n", sql, params)
+	} else {
+		_, err = fmt.Fprintf(e.w, "%s;\n", sql)
+	}
+	return err
+}
+
+func (e *dryRunExecutor) MustExec(sql string, params map[string]any) int64 {
+	return must(e.Exec(sql, params))
+}
+
+func (e *dryRunExecutor) Exec(sql string, params map[string]any) (int64, error) {
+	return 0, e.print(sql, params)
+}
+
+func (e *dryRunExecutor) MustInsert(sql string, params map[string]any, sequence string) any {
+	return must(e.Insert(sql, params, sequence))
+}
+
+func (e *dryRunExecutor) Insert(sql string, params map[string]any, sequence string) (any, error) {
+	return nil, e.print(sql, params)
+}
+
+func (e *dryRunExecutor) MustRows(sql string, params map[string]any) []map[string]any {
+	return must(e.Rows(sql, params))
+}
+
+func (e *dryRunExecutor) Rows(sql string, params map[string]any) ([]map[string]any, error) {
+	return []map[string]any{}, e.print(sql, params)
+}
+
+func (e *dryRunExecutor) MustRow(sql string, params map[string]any) map[string]any {
+	return must(e.Row(sql, params))
+}
+
+func (e *dryRunExecutor) Row(sql string, params map[string]any) (map[string]any, error) {
+	return map[string]any{}, e.print(sql, params)
+}
+
+func (e *dryRunExecutor) MustColumn(sql string, params map[string]any) []any {
+	return must(e.Column(sql, params))
+}
+
+func (e *dryRunExecutor) Column(sql string, params map[string]any) ([]any, error) {
+	return []any{}, e.print(sql, params)
+}
+
+func (e *dryRunExecutor) MustOne(sql string, params map[string]any) any {
+	return must(e.One(sql, params))
+}
+
+func (e *dryRunExecutor) One(sql string, params map[string]any) (any, error) {
+	return nil, e.print(sql, params)
+}
+
+func must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
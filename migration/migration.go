@@ -0,0 +1,171 @@
+package migration
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+)
+
+// MigrationFunc changes the database schema, the statements must be executed with the given executor
+// so that they are printed instead of being executed in the dry-run mode.
+type MigrationFunc func(db sqb.StatementExecutor) error
+
+// Migration is the versioned change of the database schema.
+// Migrations are applied in ascending order of their versions, e.g. timestamps like 20240131150405.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      MigrationFunc
+	Down    MigrationFunc
+}
+
+// SQL returns the migration function executing the statements of the script one by one.
+// Statements are separated by semicolons, semicolons inside quotes, comments and dollar-quoted strings are ignored.
+// Quotes inside quoted strings must be doubled, backslash escapes are not recognized.
+func SQL(script string) MigrationFunc {
+	statements := SplitStatements(script)
+	return func(db sqb.StatementExecutor) error {
+		for _, statement := range statements {
+			if _, err := db.Exec(statement, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+var fileNameRegexp = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// FromFS reads the migrations from the SQL files of the directory, e.g. the directory embedded with embed.FS.
+// File names have the form <version>_<name>.up.sql and <version>_<name>.down.sql, the down file is optional.
+func FromFS(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	migrations := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		matches := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("file %q: invalid version: %w", entry.Name(), err)
+		}
+		script, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		migration, exists := migrations[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: matches[2]}
+			migrations[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("file %q: migration %d is already named %q", entry.Name(), version, migration.Name)
+		}
+		if matches[3] == "up" {
+			migration.Up = SQL(string(script))
+		} else {
+			migration.Down = SQL(string(script))
+		}
+	}
+	result := make([]Migration, 0, len(migrations))
+	for _, migration := range migrations {
+		if migration.Up == nil {
+			return nil, fmt.Errorf("migration %d %s has no up file", migration.Version, migration.Name)
+		}
+		result = append(result, *migration)
+	}
+	sortMigrations(result)
+	return result, nil
+}
+
+// SplitStatements splits the SQL script into separate statements.
+func SplitStatements(script string) []string {
+	var statements []string
+	start := 0
+	for i := 0; i < len(script); i++ {
+		switch c := script[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(script, i, c)
+		case c == '-' && strings.HasPrefix(script[i:], "--"):
+			i = skipUntil(script, i, "\n")
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			i = skipUntil(script, i+2, "*/")
+		case c == '$':
+			if tag := dollarTag(script[i:]); tag != "" {
+				i = skipUntil(script, i+len(tag), tag)
+			}
+		case c == ';':
+			statements = appendStatement(statements, script[start:i])
+			start = i + 1
+		}
+	}
+	return appendStatement(statements, script[start:])
+}
+
+func skipQuoted(script string, i int, quote byte) int {
+	for i++; i < len(script); i++ {
+		if script[i] == quote {
+			if i+1 < len(script) && script[i+1] == quote {
+				i++
+			} else {
+				return i
+			}
+		}
+	}
+	return i
+}
+
+func skipUntil(script string, i int, end string) int {
+	if n := strings.Index(script[i:], end); n >= 0 {
+		return i + n + len(end) - 1
+	}
+	return len(script)
+}
+
+var dollarTagRegexp = regexp.MustCompile(`^\$[A-Za-z_]?[A-Za-z0-9_]*\$`)
+
+func dollarTag(script string) string {
+	return dollarTagRegexp.FindString(script)
+}
+
+func appendStatement(statements []string, statement string) []string {
+	if isBlank(statement) {
+		return statements
+	}
+	return append(statements, strings.TrimSpace(statement))
+}
+
+func isBlank(statement string) bool {
+	for _, line := range strings.Split(statement, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "--") {
+			return false
+		}
+	}
+	return true
+}
+
+func sortMigrations(migrations []Migration) {
+	slices.SortFunc(migrations, func(a, b Migration) int {
+		switch {
+		case a.Version < b.Version:
+			return -1
+		case a.Version > b.Version:
+			return 1
+		default:
+			return 0
+		}
+	})
+}
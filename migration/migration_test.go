@@ -0,0 +1,69 @@
+package migration
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSplitStatements(t *testing.T) {
+	statements := SplitStatements(`
+-- create the table; with comment
+CREATE TABLE t (a TEXT DEFAULT 'x;y', "b;" INT);
+/* block; comment */
+CREATE FUNCTION f() RETURNS trigger AS $body$
+BEGIN
+    RETURN NEW;
+END;
+$body$ LANGUAGE plpgsql;
+INSERT INTO t (a) VALUES ('it''s;');
+-- the end
+`)
+	expected := []string{
+		"-- create the table; with comment\nCREATE TABLE t (a TEXT DEFAULT 'x;y', \"b;\" INT)",
+		"/* block; comment */\nCREATE FUNCTION f() RETURNS trigger AS $body$\nBEGIN\n    RETURN NEW;\nEND;\n$body$ LANGUAGE plpgsql",
+		"INSERT INTO t (a) VALUES ('it''s;')",
+	}
+	if !reflect.DeepEqual(expected, statements) {
+		t.Errorf("Expected statements are %#v, actual are %#v", expected, statements)
+	}
+}
+
+func TestFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/20240102000000_add_email.up.sql":      {Data: []byte("ALTER TABLE users ADD COLUMN email TEXT;")},
+		"migrations/20240101000000_create_users.up.sql":   {Data: []byte("CREATE TABLE users (id BIGINT); CREATE INDEX ON users (id);")},
+		"migrations/20240101000000_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		"migrations/README.md":                            {Data: []byte("readme")},
+	}
+	migrations, err := FromFS(fsys, "migrations")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 {
+		t.Fatalf("Expected 2 migrations, actual are %d", len(migrations))
+	}
+	if migrations[0].Version != 20240101000000 || migrations[0].Name != "create_users" || migrations[0].Down == nil {
+		t.Errorf("Unexpected first migration %d %s", migrations[0].Version, migrations[0].Name)
+	}
+	if migrations[1].Version != 20240102000000 || migrations[1].Name != "add_email" || migrations[1].Down != nil {
+		t.Errorf("Unexpected second migration %d %s", migrations[1].Version, migrations[1].Name)
+	}
+	db := &executorMock{}
+	if err := migrations[0].Up(db); err != nil {
+		t.Fatal(err)
+	}
+	checkStatements(t, []string{"CREATE TABLE users (id BIGINT)", "CREATE INDEX ON users (id)"}, db.statements)
+}
+
+func TestFromFS_MissingUpFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"1_init.down.sql": {Data: []byte("DROP TABLE t")},
+	}
+	_, err := FromFS(fsys, ".")
+
+	if err == nil || err.Error() != "migration 1 init has no up file" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
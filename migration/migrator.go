@@ -0,0 +1,259 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/AlephTav/sqb"
+)
+
+// DefaultTable is the name of the table of applied migrations.
+const DefaultTable = "schema_migrations"
+
+// Migrator applies and reverts migrations and keeps track of the applied versions.
+// If the dialect has the lock, all migrations of the run are executed in one transaction holding the lock,
+// so a failed migration rolls back the whole run and statements that cannot run in a transaction,
+// e.g. CREATE INDEX CONCURRENTLY, are not supported. Otherwise a failed migration is not rolled back
+// and its version is not recorded.
+type Migrator struct {
+	db         sqb.StatementExecutor
+	dialect    Dialect
+	table      string
+	migrations []Migration
+	dryRun     io.Writer
+}
+
+func NewMigrator(db sqb.StatementExecutor, dialect Dialect) *Migrator {
+	return &Migrator{db: db, dialect: dialect, table: DefaultTable}
+}
+
+// Table sets the name of the table of applied migrations.
+func (m *Migrator) Table(table string) *Migrator {
+	m.table = table
+	return m
+}
+
+func (m *Migrator) Add(migrations ...Migration) *Migrator {
+	m.migrations = append(m.migrations, migrations...)
+	return m
+}
+
+// DryRun makes the migrator print the statements it would execute instead of executing them.
+// The applied versions are still read from the database.
+func (m *Migrator) DryRun(w io.Writer) *Migrator {
+	m.dryRun = w
+	return m
+}
+
+// Up applies all pending migrations and returns them.
+func (m *Migrator) Up() ([]Migration, error) {
+	return m.up(func(Migration) bool { return true })
+}
+
+// UpTo applies the pending migrations with versions less than or equal to the given one.
+func (m *Migrator) UpTo(version int64) ([]Migration, error) {
+	return m.up(func(migration Migration) bool { return migration.Version <= version })
+}
+
+func (m *Migrator) up(filter func(Migration) bool) ([]Migration, error) {
+	return m.run(func(db sqb.StatementExecutor, migrations []Migration, applied map[int64]bool) ([]Migration, error) {
+		var done []Migration
+		for _, migration := range migrations {
+			if applied[migration.Version] || !filter(migration) {
+				continue
+			}
+			if err := m.apply(db, migration, migration.Up, m.dialect.MarkApplied(m.table, migration)); err != nil {
+				return done, err
+			}
+			done = append(done, migration)
+		}
+		return done, nil
+	})
+}
+
+// Down reverts the given number of the last applied migrations and returns them.
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	return m.run(func(db sqb.StatementExecutor, migrations []Migration, applied map[int64]bool) ([]Migration, error) {
+		var done []Migration
+		for i := len(migrations) - 1; i >= 0 && len(done) < steps; i-- {
+			migration := migrations[i]
+			if !applied[migration.Version] {
+				continue
+			}
+			if migration.Down == nil {
+				return done, fmt.Errorf("migration %d %s cannot be reverted", migration.Version, migration.Name)
+			}
+			if err := m.apply(db, migration, migration.Down, m.dialect.MarkReverted(m.table, migration)); err != nil {
+				return done, err
+			}
+			done = append(done, migration)
+		}
+		return done, nil
+	})
+}
+
+// Pending returns the migrations that are not applied yet.
+func (m *Migrator) Pending() ([]Migration, error) {
+	migrations, err := m.sorted()
+	if err != nil {
+		return nil, err
+	}
+	applied, err := m.applied(m.db, true)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range migrations {
+		if !applied[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+type migrationAction func(db sqb.StatementExecutor, migrations []Migration, applied map[int64]bool) ([]Migration, error)
+
+// run executes the action in the transaction holding the lock if the dialect has the lock,
+// the migrations done by the rolled back transaction are not returned.
+func (m *Migrator) run(action migrationAction) ([]Migration, error) {
+	migrations, err := m.sorted()
+	if err != nil {
+		return nil, err
+	}
+	lock := m.dialect.Lock(m.table)
+	if m.dryRun != nil {
+		return m.migrate(newDryRunExecutor(m.dryRun), lock, migrations, action)
+	}
+	if lock == nil {
+		return m.migrate(m.db, nil, migrations, action)
+	}
+	tx, ok := m.db.(sqb.TransactionExecutor)
+	if !ok {
+		return nil, errors.New("cannot lock migrations: statement executor does not support transactions")
+	}
+	var done []Migration
+	err = tx.Transaction(func(db sqb.StatementExecutor) error {
+		done, err = m.migrate(db, lock, migrations, action)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return done, nil
+}
+
+func (m *Migrator) migrate(db sqb.StatementExecutor, lock Statement, migrations []Migration, action migrationAction) ([]Migration, error) {
+	if lock != nil {
+		if err := exec(db, lock); err != nil {
+			return nil, fmt.Errorf("cannot lock migrations: %w", err)
+		}
+	}
+	if err := exec(db, m.dialect.CreateTable(m.table)); err != nil {
+		return nil, fmt.Errorf("cannot create table %s: %w", m.table, err)
+	}
+	source := db
+	if m.dryRun != nil {
+		source = m.db
+	}
+	applied, err := m.applied(source, m.dryRun != nil)
+	if err != nil {
+		return nil, err
+	}
+	return action(db, migrations, applied)
+}
+
+func (m *Migrator) apply(db sqb.StatementExecutor, migration Migration, fn MigrationFunc, mark Statement) error {
+	if err := fn(db); err != nil {
+		return fmt.Errorf("migration %d %s: %w", migration.Version, migration.Name, err)
+	}
+	if err := exec(db, mark); err != nil {
+		return fmt.Errorf("migration %d %s: cannot record version: %w", migration.Version, migration.Name, err)
+	}
+	return nil
+}
+
+// applied reads the applied versions, the version table may not exist yet if checkTable is true.
+func (m *Migrator) applied(db sqb.StatementExecutor, checkTable bool) (map[int64]bool, error) {
+	applied := make(map[int64]bool)
+	if checkTable {
+		exists, err := db.One(statementParts(m.dialect.TableExists(m.table)))
+		if err != nil {
+			return nil, err
+		}
+		if !toBool(exists) {
+			return applied, nil
+		}
+	}
+	rows, err := db.Rows(statementParts(m.dialect.Applied(m.table)))
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		version, err := toInt64(row["version"])
+		if err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+	return applied, nil
+}
+
+func (m *Migrator) sorted() ([]Migration, error) {
+	migrations := append([]Migration(nil), m.migrations...)
+	sortMigrations(migrations)
+	for i, migration := range migrations {
+		if migration.Up == nil {
+			return nil, fmt.Errorf("migration %d %s has no up function", migration.Version, migration.Name)
+		}
+		if i > 0 && migrations[i-1].Version == migration.Version {
+			return nil, fmt.Errorf("duplicate migration version %d", migration.Version)
+		}
+	}
+	return migrations, nil
+}
+
+func exec(db sqb.StatementExecutor, statement Statement) error {
+	_, err := db.Exec(statementParts(statement))
+	return err
+}
+
+func statementParts(statement Statement) (string, map[string]any) {
+	return statement.String(), statement.Params()
+}
+
+func toBool(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case nil:
+		return false
+	default:
+		n, err := toInt64(v)
+		return err == nil && n != 0
+	}
+}
+
+func toInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case uint8:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case []byte:
+		return strconv.ParseInt(string(v), 10, 64)
+	case string:
+		return strconv.ParseInt(v, 10, 64)
+	default:
+		return 0, fmt.Errorf("unexpected migration version %#v", value)
+	}
+}
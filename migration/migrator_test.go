@@ -0,0 +1,255 @@
+package migration
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/AlephTav/sqb"
+)
+
+type executorMock struct {
+	sqb.StatementExecutorMock
+	tableExists bool
+	versions    []int64
+	statements  []string
+	params      []map[string]any
+	failOn      string
+}
+
+func (m *executorMock) Exec(sql string, params map[string]any) (int64, error) {
+	if m.failOn != "" && strings.Contains(sql, m.failOn) {
+		return 0, errors.New("failed")
+	}
+	m.statements = append(m.statements, sql)
+	m.params = append(m.params, params)
+	return 0, nil
+}
+
+func (m *executorMock) Transaction(callback func(db sqb.StatementExecutor) error) error {
+	m.statements = append(m.statements, "BEGIN")
+	m.params = append(m.params, nil)
+	err := callback(m)
+	if err != nil {
+		m.statements = append(m.statements, "ROLLBACK")
+	} else {
+		m.statements = append(m.statements, "COMMIT")
+	}
+	m.params = append(m.params, nil)
+	return err
+}
+
+func (m *executorMock) Rows(sql string, params map[string]any) ([]map[string]any, error) {
+	rows := make([]map[string]any, 0, len(m.versions))
+	for _, version := range m.versions {
+		rows = append(rows, map[string]any{"version": version})
+	}
+	return rows, nil
+}
+
+func (m *executorMock) One(sql string, params map[string]any) (any, error) {
+	return m.tableExists, nil
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 2, Name: "add_email", Up: SQL("ALTER TABLE users ADD COLUMN email TEXT"), Down: SQL("ALTER TABLE users DROP COLUMN email")},
+		{Version: 1, Name: "create_users", Up: SQL("CREATE TABLE users (id BIGINT)"), Down: SQL("DROP TABLE users")},
+		{Version: 3, Name: "seed", Up: func(db sqb.StatementExecutor) error {
+			_, err := db.Exec("INSERT INTO users (id) VALUES (1)", nil)
+			return err
+		}},
+	}
+}
+
+func checkStatements(t *testing.T, expected []string, actual []string) {
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected statements are %#v, actual are %#v", expected, actual)
+	}
+}
+
+func TestMigrator_UpPostgreSQL(t *testing.T) {
+	sqb.ResetParameterIndex()
+	db := &executorMock{versions: []int64{1}}
+	done, err := NewMigrator(db, PostgreSQL()).Add(testMigrations()...).Up()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 2 || done[0].Version != 2 || done[1].Version != 3 {
+		t.Errorf("Expected migrations 2 and 3 to be applied, actual are %v", done)
+	}
+	lock := lockKey(DefaultTable)
+	checkStatements(t, []string{
+		"BEGIN",
+		"SELECT pg_advisory_xact_lock(" + strconv.FormatInt(lock, 10) + ")",
+		"CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT PRIMARY KEY, name TEXT NOT NULL, " +
+			"applied_at TIMESTAMPTZ NOT NULL DEFAULT now())",
+		"ALTER TABLE users ADD COLUMN email TEXT",
+		"INSERT INTO schema_migrations (version, name) VALUES (:p1, :p2)",
+		"INSERT INTO users (id) VALUES (1)",
+		"INSERT INTO schema_migrations (version, name) VALUES (:p3, :p4)",
+		"COMMIT",
+	}, db.statements)
+	sqb.CheckParams(t, map[string]any{"p1": int64(2), "p2": "add_email"}, db.params[4])
+	sqb.CheckParams(t, map[string]any{"p3": int64(3), "p4": "seed"}, db.params[6])
+}
+
+func TestMigrator_UpTo(t *testing.T) {
+	db := &executorMock{}
+	done, err := NewMigrator(db, ClickHouse()).Table("migrations").Add(testMigrations()...).UpTo(2)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 2 || done[0].Version != 1 || done[1].Version != 2 {
+		t.Errorf("Expected migrations 1 and 2 to be applied, actual are %v", done)
+	}
+	if len(db.statements) != 5 {
+		t.Fatalf("Expected 5 statements, actual are %#v", db.statements)
+	}
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE IF NOT EXISTS migrations (version Int64, name String, applied UInt8, "+
+			"created_at DateTime64(6) DEFAULT now64(6)) ENGINE = MergeTree ORDER BY (version, created_at)",
+		db.statements[0],
+	)
+	sqb.CheckSql(t, "CREATE TABLE users (id BIGINT)", db.statements[1])
+}
+
+func TestMigrator_DownClickHouse(t *testing.T) {
+	sqb.ResetParameterIndex()
+	db := &executorMock{versions: []int64{1, 2}}
+	done, err := NewMigrator(db, ClickHouse()).Add(testMigrations()...).Down(1)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 1 || done[0].Version != 2 {
+		t.Errorf("Expected migration 2 to be reverted, actual are %v", done)
+	}
+	checkStatements(t, []string{
+		"CREATE TABLE IF NOT EXISTS schema_migrations (version Int64, name String, applied UInt8, " +
+			"created_at DateTime64(6) DEFAULT now64(6)) ENGINE = MergeTree ORDER BY (version, created_at)",
+		"ALTER TABLE users DROP COLUMN email",
+		"INSERT INTO schema_migrations (version, name, applied) VALUES (:p1, :p2, :p3)",
+	}, db.statements)
+	sqb.CheckParams(t, map[string]any{"p1": int64(2), "p2": "add_email", "p3": 0}, db.params[2])
+}
+
+func TestMigrator_DownPostgreSQL(t *testing.T) {
+	sqb.ResetParameterIndex()
+	db := &executorMock{versions: []int64{1, 2}}
+	_, err := NewMigrator(db, PostgreSQL()).Add(testMigrations()...).Down(2)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqb.CheckSql(t, "DELETE FROM schema_migrations WHERE version = :p1", db.statements[4])
+	sqb.CheckSql(t, "DELETE FROM schema_migrations WHERE version = :p2", db.statements[6])
+}
+
+func TestMigrator_DownWithoutDownFunction(t *testing.T) {
+	db := &executorMock{versions: []int64{1, 2, 3}}
+	done, err := NewMigrator(db, ClickHouse()).Add(testMigrations()...).Down(1)
+
+	if err == nil || err.Error() != "migration 3 seed cannot be reverted" {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if len(done) != 0 {
+		t.Errorf("Expected no reverted migrations, actual are %v", done)
+	}
+}
+
+func TestMigrator_FailedMigration(t *testing.T) {
+	db := &executorMock{failOn: "email"}
+	done, err := NewMigrator(db, PostgreSQL()).Add(testMigrations()...).Up()
+
+	if err == nil || err.Error() != "migration 2 add_email: failed" {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if len(done) != 0 {
+		t.Errorf("Expected no migrations to be applied, actual are %v", done)
+	}
+	if last := db.statements[len(db.statements)-1]; last != "ROLLBACK" {
+		t.Errorf("Expected transaction to be rolled back, the last statement is %q", last)
+	}
+}
+
+func TestMigrator_LockWithoutTransactions(t *testing.T) {
+	db := sqb.NewStatementExecutorMock()
+	_, err := NewMigrator(db, PostgreSQL()).Add(testMigrations()...).Up()
+
+	if err == nil || err.Error() != "cannot lock migrations: statement executor does not support transactions" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestMigrator_DuplicateVersion(t *testing.T) {
+	db := &executorMock{}
+	_, err := NewMigrator(db, PostgreSQL()).
+		Add(testMigrations()...).
+		Add(Migration{Version: 1, Name: "again", Up: SQL("SELECT 1")}).
+		Up()
+
+	if err == nil || err.Error() != "duplicate migration version 1" {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if len(db.statements) != 0 {
+		t.Errorf("Expected no statements, actual are %#v", db.statements)
+	}
+}
+
+func TestMigrator_DryRun(t *testing.T) {
+	sqb.ResetParameterIndex()
+	db := &executorMock{tableExists: true, versions: []int64{1}}
+	var out strings.Builder
+	done, err := NewMigrator(db, ClickHouse()).Add(testMigrations()...).DryRun(&out).Up()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 2 {
+		t.Errorf("Expected 2 migrations, actual are %v", done)
+	}
+	if len(db.statements) != 0 {
+		t.Errorf("Expected no executed statements, actual are %#v", db.statements)
+	}
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE IF NOT EXISTS schema_migrations (version Int64, name String, applied UInt8, "+
+			"created_at DateTime64(6) DEFAULT now64(6)) ENGINE = MergeTree ORDER BY (version, created_at);\n"+
+			"ALTER TABLE users ADD COLUMN email TEXT;\n"+
+			"INSERT INTO schema_migrations (version, name, applied) VALUES (:p1, :p2, :p3); -- map[p1:2 p2:add_email p3:1]\n"+
+			"INSERT INTO users (id) VALUES (1);\n"+
+			"INSERT INTO schema_migrations (version, name, applied) VALUES (:p4, :p5, :p6); -- map[p4:3 p5:seed p6:1]\n",
+		out.String(),
+	)
+}
+
+func TestMigrator_DryRunWithoutTable(t *testing.T) {
+	db := &executorMock{versions: []int64{1, 2}}
+	var out strings.Builder
+	done, err := NewMigrator(db, PostgreSQL()).Add(testMigrations()...).DryRun(&out).Up()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(done) != 3 {
+		t.Errorf("Expected 3 migrations, actual are %v", done)
+	}
+}
+
+func TestMigrator_Pending(t *testing.T) {
+	db := &executorMock{tableExists: true, versions: []int64{1, 3}}
+	pending, err := NewMigrator(db, PostgreSQL()).Add(testMigrations()...).Pending()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 1 || pending[0].Version != 2 {
+		t.Errorf("Expected migration 2 to be pending, actual are %v", pending)
+	}
+}
@@ -0,0 +1,52 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/execution"
+	postgresql "github.com/AlephTav/sqb/postgresql/clause"
+	"github.com/AlephTav/sqb/sql"
+)
+
+type AlterTableStmt struct {
+	*execution.StatementExecution[*AlterTableStmt]
+	*sql.BaseStatement[*AlterTableStmt]
+	*postgresql.AlterTableClause[*AlterTableStmt]
+	*postgresql.AlterActionsClause[*AlterTableStmt]
+}
+
+func NewAlterTableStmt(db sqb.StatementExecutor) *AlterTableStmt {
+	st := &AlterTableStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*AlterTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterTableStmt](st, db)
+	st.AlterTableClause = postgresql.NewAlterTableClause[*AlterTableStmt](st)
+	st.AlterActionsClause = postgresql.NewAlterActionsClause[*AlterTableStmt](st)
+	return st
+}
+
+func (s *AlterTableStmt) ItIsCommand() {}
+
+func (s *AlterTableStmt) Clean() *AlterTableStmt {
+	s.CleanAlterTable()
+	s.CleanAlterActions()
+	return s
+}
+
+func (s *AlterTableStmt) Copy() *AlterTableStmt {
+	st := &AlterTableStmt{}
+	st.AlterTableClause = s.CopyAlterTable(st)
+	st.AlterActionsClause = s.CopyAlterActions(st)
+	st.StatementExecution = execution.NewStatementExecution[*AlterTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*AlterTableStmt](st, s.Executor())
+	return st
+}
+
+func (s *AlterTableStmt) Build() *AlterTableStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildAlterTable()
+	s.BuildAlterActions()
+	s.Built()
+	return s
+}
@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/postgresql/clause"
+	"testing"
+)
+
+func TestAlterTableStmt_EmptyAlterTable(t *testing.T) {
+	st := NewAlterTableStmt(nil)
+
+	sqb.CheckSql(t, "ALTER TABLE", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestAlterTableStmt_Columns(t *testing.T) {
+	st := NewAlterTableStmt(nil).
+		IfExists().
+		OnlyTable("users").
+		AddColumn("age", "int", "NOT NULL", "DEFAULT 0").
+		AddColumnIfNotExists(postgresql.NewColumnDef("email", "text").Unique()).
+		DropColumn("legacy", "CASCADE").
+		DropColumnIfExists("tmp").
+		AlterColumnType("id", "bigint", "id::bigint").
+		SetDefault("status", "'new'").
+		DropDefault("score").
+		SetNotNull("name").
+		DropNotNull("note")
+
+	sqb.CheckSql(
+		t,
+		"ALTER TABLE IF EXISTS ONLY users "+
+			"ADD COLUMN age int NOT NULL DEFAULT 0, "+
+			"ADD COLUMN IF NOT EXISTS email text UNIQUE, "+
+			"DROP COLUMN legacy CASCADE, "+
+			"DROP COLUMN IF EXISTS tmp, "+
+			"ALTER COLUMN id TYPE bigint USING id::bigint, "+
+			"ALTER COLUMN status SET DEFAULT 'new', "+
+			"ALTER COLUMN score DROP DEFAULT, "+
+			"ALTER COLUMN name SET NOT NULL, "+
+			"ALTER COLUMN note DROP NOT NULL",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestAlterTableStmt_Constraints(t *testing.T) {
+	st := NewAlterTableStmt(nil).
+		Table("orders").
+		AddConstraint(postgresql.ForeignKey("user_id").References("users", "id").Named("orders_user_fk")).
+		DropConstraint("orders_old_fk").
+		DropConstraintIfExists("orders_tmp", "RESTRICT")
+
+	sqb.CheckSql(
+		t,
+		"ALTER TABLE orders "+
+			"ADD CONSTRAINT orders_user_fk FOREIGN KEY (user_id) REFERENCES users (id), "+
+			"DROP CONSTRAINT orders_old_fk, "+
+			"DROP CONSTRAINT IF EXISTS orders_tmp RESTRICT",
+		st.String(),
+	)
+}
+
+func TestAlterTableStmt_Rename(t *testing.T) {
+	sqb.CheckSql(t, "ALTER TABLE tb RENAME COLUMN a TO b", NewAlterTableStmt(nil).Table("tb").RenameColumn("a", "b").String())
+	sqb.CheckSql(t, "ALTER TABLE tb RENAME CONSTRAINT a TO b", NewAlterTableStmt(nil).Table("tb").RenameConstraint("a", "b").String())
+	sqb.CheckSql(t, "ALTER TABLE tb RENAME TO tb2", NewAlterTableStmt(nil).Table("tb").RenameTo("tb2").String())
+	sqb.CheckSql(t, "ALTER TABLE tb SET SCHEMA archive", NewAlterTableStmt(nil).Table("tb").SetSchema("archive").String())
+}
+
+func TestAlterTableStmt_Copy(t *testing.T) {
+	origin := NewAlterTableStmt(nil).Table("tb").DropColumn("a")
+	copied := origin.Copy().DropColumn("b")
+
+	sqb.CheckSql(t, "ALTER TABLE tb DROP COLUMN a", origin.String())
+	sqb.CheckSql(t, "ALTER TABLE tb DROP COLUMN a, DROP COLUMN b", copied.String())
+}
+
+func TestAlterTableStmt_Clean(t *testing.T) {
+	st := NewAlterTableStmt(nil).IfExists().Table("tb").DropColumn("a").Clean()
+
+	sqb.CheckSql(t, "ALTER TABLE", st.String())
+}
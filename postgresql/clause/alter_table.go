@@ -0,0 +1,216 @@
+package postgresql
+
+import (
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+type AlterTableClause[T sqb.Statement[T]] struct {
+	self     T
+	exp      exp.DirectListExpression
+	ifExists bool
+	only     bool
+}
+
+func NewAlterTableClause[T sqb.Statement[T]](self T) *AlterTableClause[T] {
+	return &AlterTableClause[T]{self, exp.EmptyDirectListExp(), false, false}
+}
+
+func (a *AlterTableClause[T]) Table(table any) T {
+	a.exp.Clean()
+	a.exp.Append(table)
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AlterTableClause[T]) OnlyTable(table any) T {
+	a.only = true
+	return a.Table(table)
+}
+
+func (a *AlterTableClause[T]) IfExists() T {
+	a.ifExists = true
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AlterTableClause[T]) CleanAlterTable() T {
+	a.exp.Clean()
+	a.ifExists = false
+	a.only = false
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AlterTableClause[T]) CopyAlterTable(self T) *AlterTableClause[T] {
+	return &AlterTableClause[T]{self, a.exp.Copy(), a.ifExists, a.only}
+}
+
+func (a *AlterTableClause[T]) BuildAlterTable() T {
+	a.self.AddSql("ALTER TABLE")
+	if a.ifExists {
+		a.self.AddSql(" IF EXISTS")
+	}
+	if a.only {
+		a.self.AddSql(" ONLY")
+	}
+	if a.exp.IsNotEmpty() {
+		a.self.AddParams(a.exp.Params())
+		a.self.AddSql(" ")
+		a.self.AddSql(a.exp.String())
+	}
+	return a.self
+}
+
+// AlterActionsClause is the comma separated list of actions of the ALTER TABLE statement.
+type AlterActionsClause[T sqb.Statement[T]] struct {
+	self    T
+	actions []string
+	err     error
+}
+
+func NewAlterActionsClause[T sqb.Statement[T]](self T) *AlterActionsClause[T] {
+	return &AlterActionsClause[T]{self, nil, nil}
+}
+
+// AddColumn adds the column to the table:
+//   - AddColumn(column *ColumnDef)
+//   - AddColumn(name string, dataType string, constraints ...string)
+func (a *AlterActionsClause[T]) AddColumn(column any, args ...any) T {
+	sql, err := columnDefToString(column, args)
+	return a.action("ADD COLUMN "+sql, err)
+}
+
+// AddColumnIfNotExists adds the column to the table if it does not exist:
+//   - AddColumnIfNotExists(column *ColumnDef)
+//   - AddColumnIfNotExists(name string, dataType string, constraints ...string)
+func (a *AlterActionsClause[T]) AddColumnIfNotExists(column any, args ...any) T {
+	sql, err := columnDefToString(column, args)
+	return a.action("ADD COLUMN IF NOT EXISTS "+sql, err)
+}
+
+// DropColumn drops the column:
+//   - DropColumn(column string)
+//   - DropColumn(column string, behavior string), e.g. DropColumn("c", "CASCADE")
+func (a *AlterActionsClause[T]) DropColumn(column string, args ...string) T {
+	return a.Action(withBehavior("DROP COLUMN "+column, args))
+}
+
+// DropColumnIfExists drops the column if it exists:
+//   - DropColumnIfExists(column string)
+//   - DropColumnIfExists(column string, behavior string)
+func (a *AlterActionsClause[T]) DropColumnIfExists(column string, args ...string) T {
+	return a.Action(withBehavior("DROP COLUMN IF EXISTS "+column, args))
+}
+
+// AlterColumnType changes the type of the column:
+//   - AlterColumnType(column string, dataType string)
+//   - AlterColumnType(column string, dataType string, using any)
+func (a *AlterActionsClause[T]) AlterColumnType(column string, dataType string, args ...any) T {
+	action := "ALTER COLUMN " + column + " TYPE " + dataType
+	if len(args) > 0 && args[0] != nil {
+		using, err := ddlToString(args[0])
+		return a.action(action+" USING "+using, err)
+	}
+	return a.Action(action)
+}
+
+func (a *AlterActionsClause[T]) SetDefault(column string, value any) T {
+	sql, err := ddlToString(value)
+	return a.action("ALTER COLUMN "+column+" SET DEFAULT "+sql, err)
+}
+
+func (a *AlterActionsClause[T]) DropDefault(column string) T {
+	return a.Action("ALTER COLUMN " + column + " DROP DEFAULT")
+}
+
+func (a *AlterActionsClause[T]) SetNotNull(column string) T {
+	return a.Action("ALTER COLUMN " + column + " SET NOT NULL")
+}
+
+func (a *AlterActionsClause[T]) DropNotNull(column string) T {
+	return a.Action("ALTER COLUMN " + column + " DROP NOT NULL")
+}
+
+// AddConstraint adds the table constraint:
+//   - AddConstraint(constraint *TableConstraint)
+//   - AddConstraint(constraint string)
+func (a *AlterActionsClause[T]) AddConstraint(constraint any) T {
+	sql, err := ddlToString(constraint)
+	return a.action("ADD "+sql, err)
+}
+
+// DropConstraint drops the constraint:
+//   - DropConstraint(name string)
+//   - DropConstraint(name string, behavior string)
+func (a *AlterActionsClause[T]) DropConstraint(name string, args ...string) T {
+	return a.Action(withBehavior("DROP CONSTRAINT "+name, args))
+}
+
+// DropConstraintIfExists drops the constraint if it exists:
+//   - DropConstraintIfExists(name string)
+//   - DropConstraintIfExists(name string, behavior string)
+func (a *AlterActionsClause[T]) DropConstraintIfExists(name string, args ...string) T {
+	return a.Action(withBehavior("DROP CONSTRAINT IF EXISTS "+name, args))
+}
+
+func (a *AlterActionsClause[T]) RenameColumn(column string, newName string) T {
+	return a.Action("RENAME COLUMN " + column + " TO " + newName)
+}
+
+func (a *AlterActionsClause[T]) RenameConstraint(name string, newName string) T {
+	return a.Action("RENAME CONSTRAINT " + name + " TO " + newName)
+}
+
+func (a *AlterActionsClause[T]) RenameTo(newName string) T {
+	return a.Action("RENAME TO " + newName)
+}
+
+func (a *AlterActionsClause[T]) SetSchema(schema string) T {
+	return a.Action("SET SCHEMA " + schema)
+}
+
+// Action adds the raw action to the statement.
+func (a *AlterActionsClause[T]) Action(action any) T {
+	return a.action(ddlToString(action))
+}
+
+func (a *AlterActionsClause[T]) action(action string, err error) T {
+	a.actions = append(a.actions, action)
+	if a.err == nil {
+		a.err = err
+	}
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AlterActionsClause[T]) CleanAlterActions() T {
+	a.actions = nil
+	a.err = nil
+	a.self.Dirty()
+	return a.self
+}
+
+func (a *AlterActionsClause[T]) CopyAlterActions(self T) *AlterActionsClause[T] {
+	return &AlterActionsClause[T]{self, append([]string(nil), a.actions...), a.err}
+}
+
+func (a *AlterActionsClause[T]) BuildAlterActions() T {
+	if a.err != nil {
+		sqb.Fail(a.self, a.err)
+	}
+	if len(a.actions) > 0 {
+		a.self.AddSql(" ")
+		a.self.AddSql(strings.Join(a.actions, ", "))
+	}
+	return a.self
+}
+
+func withBehavior(action string, args []string) string {
+	if len(args) > 0 && args[0] != "" {
+		return action + " " + args[0]
+	}
+	return action
+}
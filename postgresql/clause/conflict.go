@@ -14,6 +14,7 @@ type ConflictClause[T sqb.Statement[T]] struct {
 	indexConstraint        string
 	whereBelongsToConflict bool
 	used                   bool
+	err                    error
 }
 
 func NewConflictClause[T sqb.Statement[T]](self T) *ConflictClause[T] {
@@ -26,6 +27,7 @@ func NewConflictClause[T sqb.Statement[T]](self T) *ConflictClause[T] {
 		"",
 		false,
 		false,
+		nil,
 	}
 }
 
@@ -88,6 +90,25 @@ func (c *ConflictClause[T]) OnConflict(args ...any) T {
 	return c.self
 }
 
+// OnConflictIndex uses the columns, expressions and predicate of the index definition as the conflict target.
+func (c *ConflictClause[T]) OnConflictIndex(def *IndexDef) T {
+	c.indexColumn.Append(def.targets.Expression)
+	if c.err == nil {
+		c.err = def.err
+	}
+	if def.predicate.IsNotEmpty() {
+		if c.indexPredicate.IsEmpty() {
+			c.indexPredicate = def.predicate.Copy()
+		} else {
+			c.indexPredicate.Where(def.predicate)
+		}
+	}
+	c.whereBelongsToConflict = true
+	c.used = true
+	c.self.Dirty()
+	return c.self
+}
+
 func (c *ConflictClause[T]) OnConstraint(indexConstraint string) T {
 	c.indexConstraint = indexConstraint
 	c.self.Dirty()
@@ -194,6 +215,7 @@ func (c *ConflictClause[T]) CleanConflict() T {
 	c.indexConstraint = ""
 	c.whereBelongsToConflict = false
 	c.used = false
+	c.err = nil
 	c.self.Dirty()
 	return c.self
 }
@@ -208,10 +230,14 @@ func (c *ConflictClause[T]) CopyConflict(self T) *ConflictClause[T] {
 		c.indexConstraint,
 		c.whereBelongsToConflict,
 		c.used,
+		c.err,
 	}
 }
 
 func (c *ConflictClause[T]) BuildConflict() T {
+	if c.err != nil {
+		sqb.Fail(c.self, c.err)
+	}
 	if !c.used && c.indexColumn.IsEmpty() && c.indexPredicate.IsEmpty() &&
 		c.indexConstraint == "" && c.assignment.IsEmpty() {
 		return c.self
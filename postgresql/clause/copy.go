@@ -0,0 +1,229 @@
+package postgresql
+
+import (
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+// CopyClause is the table or query and the data source or destination of the COPY statement.
+// COPY is a utility statement, so neither the query nor the WHERE condition can have parameters.
+type CopyClause[T sqb.Statement[T]] struct {
+	self      T
+	table     exp.DirectListExpression
+	query     sqb.Query
+	direction string
+	target    string
+}
+
+func NewCopyClause[T sqb.Statement[T]](self T) *CopyClause[T] {
+	return &CopyClause[T]{self: self, table: exp.EmptyDirectListExp()}
+}
+
+// Table sets the table to copy data from or to, the columns are set by Columns.
+func (c *CopyClause[T]) Table(table any) T {
+	c.table.Clean()
+	c.table.Append(table)
+	c.query = nil
+	c.self.Dirty()
+	return c.self
+}
+
+// Query sets the query whose results are copied, it can be used with COPY TO only.
+func (c *CopyClause[T]) Query(query sqb.Query) T {
+	c.table.Clean()
+	c.query = query
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CopyClause[T]) FromStdin() T {
+	return c.setDirection("FROM", "STDIN")
+}
+
+func (c *CopyClause[T]) ToStdout() T {
+	return c.setDirection("TO", "STDOUT")
+}
+
+// FromFile reads the data from the file on the database server.
+func (c *CopyClause[T]) FromFile(name string) T {
+	return c.setDirection("FROM", quoteLiteral(name))
+}
+
+// ToFile writes the data to the file on the database server.
+func (c *CopyClause[T]) ToFile(name string) T {
+	return c.setDirection("TO", quoteLiteral(name))
+}
+
+// FromProgram reads the data from the output of the command executed by the database server.
+func (c *CopyClause[T]) FromProgram(command string) T {
+	return c.setDirection("FROM", "PROGRAM "+quoteLiteral(command))
+}
+
+// ToProgram writes the data to the input of the command executed by the database server.
+func (c *CopyClause[T]) ToProgram(command string) T {
+	return c.setDirection("TO", "PROGRAM "+quoteLiteral(command))
+}
+
+func (c *CopyClause[T]) setDirection(direction, target string) T {
+	c.direction = direction
+	c.target = target
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CopyClause[T]) HasQuery() bool {
+	return c.query != nil
+}
+
+// IsCopyFrom returns true if the statement loads data into the table.
+func (c *CopyClause[T]) IsCopyFrom() bool {
+	return c.direction == "FROM"
+}
+
+func (c *CopyClause[T]) CleanCopy() T {
+	c.table.Clean()
+	c.query = nil
+	c.direction = ""
+	c.target = ""
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CopyClause[T]) CopyCopy(self T) *CopyClause[T] {
+	return &CopyClause[T]{self, c.table.Copy(), c.query, c.direction, c.target}
+}
+
+func (c *CopyClause[T]) BuildCopy() T {
+	c.self.AddSql("COPY ")
+	if c.query != nil {
+		c.self.AddParams(exp.NestedParams(c.query))
+		c.self.AddSql("(")
+		c.self.AddSql(c.query.String())
+		c.self.AddSql(")")
+	} else {
+		c.self.AddParams(c.table.Params())
+		c.self.AddSql(c.table.String())
+	}
+	return c.self
+}
+
+func (c *CopyClause[T]) BuildCopyDirection() T {
+	if c.direction != "" {
+		c.self.AddSql(" ")
+		c.self.AddSql(c.direction)
+		c.self.AddSql(" ")
+		c.self.AddSql(c.target)
+	}
+	return c.self
+}
+
+// CopyOptionsClause is the list of options of the COPY statement.
+type CopyOptionsClause[T sqb.Statement[T]] struct {
+	self    T
+	options []string
+}
+
+func NewCopyOptionsClause[T sqb.Statement[T]](self T) *CopyOptionsClause[T] {
+	return &CopyOptionsClause[T]{self, nil}
+}
+
+// Format sets the data format: text, csv or binary.
+func (c *CopyOptionsClause[T]) Format(format string) T {
+	return c.Option("FORMAT", format)
+}
+
+func (c *CopyOptionsClause[T]) CSV() T {
+	return c.Format("csv")
+}
+
+func (c *CopyOptionsClause[T]) Binary() T {
+	return c.Format("binary")
+}
+
+func (c *CopyOptionsClause[T]) Delimiter(delimiter string) T {
+	return c.Option("DELIMITER", quoteLiteral(delimiter))
+}
+
+// Null sets the string that represents a null value.
+func (c *CopyOptionsClause[T]) Null(null string) T {
+	return c.Option("NULL", quoteLiteral(null))
+}
+
+// Header turns on the header line of the CSV format:
+//   - Header() adds HEADER true
+//   - Header(value string), e.g. Header("MATCH") to check the column names on COPY FROM
+func (c *CopyOptionsClause[T]) Header(args ...string) T {
+	if len(args) > 0 && args[0] != "" {
+		return c.Option("HEADER", args[0])
+	}
+	return c.Option("HEADER", "true")
+}
+
+func (c *CopyOptionsClause[T]) Quote(quote string) T {
+	return c.Option("QUOTE", quoteLiteral(quote))
+}
+
+func (c *CopyOptionsClause[T]) Escape(escape string) T {
+	return c.Option("ESCAPE", quoteLiteral(escape))
+}
+
+func (c *CopyOptionsClause[T]) Encoding(encoding string) T {
+	return c.Option("ENCODING", quoteLiteral(encoding))
+}
+
+// ForceQuote quotes all non-null values of the columns on COPY TO, "*" means all columns.
+func (c *CopyOptionsClause[T]) ForceQuote(columns ...string) T {
+	if len(columns) == 1 && columns[0] == "*" {
+		return c.Option("FORCE_QUOTE", "*")
+	}
+	return c.Option("FORCE_QUOTE", "("+strings.Join(columns, ", ")+")")
+}
+
+func (c *CopyOptionsClause[T]) ForceNotNull(columns ...string) T {
+	return c.Option("FORCE_NOT_NULL", "("+strings.Join(columns, ", ")+")")
+}
+
+func (c *CopyOptionsClause[T]) ForceNull(columns ...string) T {
+	return c.Option("FORCE_NULL", "("+strings.Join(columns, ", ")+")")
+}
+
+func (c *CopyOptionsClause[T]) Freeze() T {
+	return c.Option("FREEZE", "true")
+}
+
+// Option adds the raw option, the value is not quoted:
+//   - Option(name string)
+//   - Option(name string, value string)
+func (c *CopyOptionsClause[T]) Option(name string, args ...string) T {
+	if len(args) > 0 && args[0] != "" {
+		name += " " + args[0]
+	}
+	c.options = append(c.options, name)
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CopyOptionsClause[T]) CleanCopyOptions() T {
+	c.options = nil
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CopyOptionsClause[T]) CopyCopyOptions(self T) *CopyOptionsClause[T] {
+	return &CopyOptionsClause[T]{self, append([]string(nil), c.options...)}
+}
+
+func (c *CopyOptionsClause[T]) BuildCopyOptions() T {
+	if len(c.options) > 0 {
+		c.self.AddSql(" WITH (")
+		c.self.AddSql(strings.Join(c.options, ", "))
+		c.self.AddSql(")")
+	}
+	return c.self
+}
+
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
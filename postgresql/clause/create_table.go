@@ -0,0 +1,76 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+type CreateTableClause[T sqb.Statement[T]] struct {
+	self        T
+	exp         exp.DirectListExpression
+	kind        string
+	ifNotExists bool
+}
+
+func NewCreateTableClause[T sqb.Statement[T]](self T) *CreateTableClause[T] {
+	return &CreateTableClause[T]{self, exp.EmptyDirectListExp(), "", false}
+}
+
+func (c *CreateTableClause[T]) Table(table any) T {
+	c.exp.Clean()
+	c.exp.Append(table)
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateTableClause[T]) HasTable() bool {
+	return c.exp.IsNotEmpty()
+}
+
+func (c *CreateTableClause[T]) IfNotExists() T {
+	c.ifNotExists = true
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateTableClause[T]) Temporary() T {
+	c.kind = "TEMPORARY"
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateTableClause[T]) Unlogged() T {
+	c.kind = "UNLOGGED"
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateTableClause[T]) CleanCreateTable() T {
+	c.exp.Clean()
+	c.kind = ""
+	c.ifNotExists = false
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateTableClause[T]) CopyCreateTable(self T) *CreateTableClause[T] {
+	return &CreateTableClause[T]{self, c.exp.Copy(), c.kind, c.ifNotExists}
+}
+
+func (c *CreateTableClause[T]) BuildCreateTable() T {
+	c.self.AddSql("CREATE ")
+	if c.kind != "" {
+		c.self.AddSql(c.kind)
+		c.self.AddSql(" ")
+	}
+	c.self.AddSql("TABLE")
+	if c.ifNotExists {
+		c.self.AddSql(" IF NOT EXISTS")
+	}
+	if c.exp.IsNotEmpty() {
+		c.self.AddParams(c.exp.Params())
+		c.self.AddSql(" ")
+		c.self.AddSql(c.exp.String())
+	}
+	return c.self
+}
@@ -3,15 +3,18 @@ package postgresql
 import (
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
 )
 
 type DeleteClause[T sqb.Statement[T]] struct {
 	*sql.DeleteClause[T]
-	only bool
+	only       bool
+	softDelete exp.AssignmentExpression
+	self       T
 }
 
 func NewDeleteClause[T sqb.Statement[T]](self T) *DeleteClause[T] {
-	return &DeleteClause[T]{sql.NewDeleteClause[T](self), false}
+	return &DeleteClause[T]{sql.NewDeleteClause[T](self), false, exp.EmptyAssignmentExp(), self}
 }
 
 // FromOnly adds table name and its alias to the "delete from only" clause:
@@ -22,26 +25,59 @@ func (d *DeleteClause[T]) FromOnly(table any, args ...any) T {
 	return d.DeleteClause.From(table, args...)
 }
 
+// SoftDelete turns the statement into the UPDATE statement that marks the rows as deleted:
+//   - SoftDelete() sets "deleted_at" column to now()
+//   - SoftDelete(column any) sets the column to now()
+//   - SoftDelete(column any, value any)
+func (d *DeleteClause[T]) SoftDelete(args ...any) T {
+	var column, value any = "deleted_at", exp.NewExp("now()")
+	if len(args) > 0 {
+		column = args[0]
+	}
+	if len(args) > 1 {
+		value = args[1]
+	}
+	d.softDelete.Clean()
+	d.softDelete.Append(column, value)
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DeleteClause[T]) IsSoftDelete() bool {
+	return d.softDelete.IsNotEmpty()
+}
+
 func (d *DeleteClause[T]) CleanDelete() T {
 	d.only = false
+	d.softDelete.Clean()
 	return d.DeleteClause.CleanDelete()
 }
 
 func (d *DeleteClause[T]) CopyDelete(self T) *DeleteClause[T] {
-	return &DeleteClause[T]{d.DeleteClause.CopyDelete(self), d.only}
+	return &DeleteClause[T]{d.DeleteClause.CopyDelete(self), d.only, d.softDelete.Copy(), self}
 }
 
 func (d *DeleteClause[T]) BuildDelete() T {
-	self, exp := d.DeleteClause.BuildDelete()
-	if exp.IsEmpty() {
+	self, tables := d.DeleteClause.BuildDelete()
+	if d.IsSoftDelete() {
+		self.AddParams(tables.Params())
+		self.AddSql("UPDATE ")
+		if d.only {
+			self.AddSql("ONLY ")
+		}
+		self.AddSql(tables.String())
+		self.AddParams(d.softDelete.Params())
+		self.AddSql(" SET ")
+		self.AddSql(d.softDelete.String())
+	} else if tables.IsEmpty() {
 		self.AddSql("DELETE FROM")
 	} else {
-		self.AddParams(exp.Params())
+		self.AddParams(tables.Params())
 		self.AddSql("DELETE FROM ")
 		if d.only {
 			self.AddSql("ONLY ")
 		}
-		self.AddSql(exp.String())
+		self.AddSql(tables.String())
 	}
 	return self
 }
@@ -0,0 +1,83 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+type DropIndexClause[T sqb.Statement[T]] struct {
+	self         T
+	exp          exp.DirectListExpression
+	concurrently bool
+	ifExists     bool
+	behavior     string
+}
+
+func NewDropIndexClause[T sqb.Statement[T]](self T) *DropIndexClause[T] {
+	return &DropIndexClause[T]{self, exp.EmptyDirectListExp(), false, false, ""}
+}
+
+// Index adds the index to drop:
+//   - Index(index any)
+func (d *DropIndexClause[T]) Index(index any) T {
+	d.exp.Append(index)
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropIndexClause[T]) Concurrently() T {
+	d.concurrently = true
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropIndexClause[T]) IfExists() T {
+	d.ifExists = true
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropIndexClause[T]) Cascade() T {
+	d.behavior = "CASCADE"
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropIndexClause[T]) Restrict() T {
+	d.behavior = "RESTRICT"
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropIndexClause[T]) CleanDropIndex() T {
+	d.exp.Clean()
+	d.concurrently = false
+	d.ifExists = false
+	d.behavior = ""
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropIndexClause[T]) CopyDropIndex(self T) *DropIndexClause[T] {
+	return &DropIndexClause[T]{self, d.exp.Copy(), d.concurrently, d.ifExists, d.behavior}
+}
+
+func (d *DropIndexClause[T]) BuildDropIndex() T {
+	d.self.AddSql("DROP INDEX")
+	if d.concurrently {
+		d.self.AddSql(" CONCURRENTLY")
+	}
+	if d.ifExists {
+		d.self.AddSql(" IF EXISTS")
+	}
+	if d.exp.IsNotEmpty() {
+		d.self.AddParams(d.exp.Params())
+		d.self.AddSql(" ")
+		d.self.AddSql(d.exp.String())
+	}
+	if d.behavior != "" {
+		d.self.AddSql(" ")
+		d.self.AddSql(d.behavior)
+	}
+	return d.self
+}
@@ -0,0 +1,72 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+type DropTableClause[T sqb.Statement[T]] struct {
+	self     T
+	exp      exp.DirectListExpression
+	ifExists bool
+	behavior string
+}
+
+func NewDropTableClause[T sqb.Statement[T]](self T) *DropTableClause[T] {
+	return &DropTableClause[T]{self, exp.EmptyDirectListExp(), false, ""}
+}
+
+// Table adds the table to drop:
+//   - Table(table any)
+func (d *DropTableClause[T]) Table(table any) T {
+	d.exp.Append(table)
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropTableClause[T]) IfExists() T {
+	d.ifExists = true
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropTableClause[T]) Cascade() T {
+	d.behavior = "CASCADE"
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropTableClause[T]) Restrict() T {
+	d.behavior = "RESTRICT"
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropTableClause[T]) CleanDropTable() T {
+	d.exp.Clean()
+	d.ifExists = false
+	d.behavior = ""
+	d.self.Dirty()
+	return d.self
+}
+
+func (d *DropTableClause[T]) CopyDropTable(self T) *DropTableClause[T] {
+	return &DropTableClause[T]{self, d.exp.Copy(), d.ifExists, d.behavior}
+}
+
+func (d *DropTableClause[T]) BuildDropTable() T {
+	d.self.AddSql("DROP TABLE")
+	if d.ifExists {
+		d.self.AddSql(" IF EXISTS")
+	}
+	if d.exp.IsNotEmpty() {
+		d.self.AddParams(d.exp.Params())
+		d.self.AddSql(" ")
+		d.self.AddSql(d.exp.String())
+	}
+	if d.behavior != "" {
+		d.self.AddSql(" ")
+		d.self.AddSql(d.behavior)
+	}
+	return d.self
+}
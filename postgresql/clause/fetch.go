@@ -0,0 +1,74 @@
+package postgresql
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/AlephTav/sqb"
+)
+
+// FetchClause is the standard FETCH FIRST n ROWS ONLY | WITH TIES clause, the alternative of LIMIT.
+type FetchClause[T sqb.Statement[T]] struct {
+	self     T
+	count    int
+	withTies bool
+}
+
+func NewFetchClause[T sqb.Statement[T]](self T) *FetchClause[T] {
+	return &FetchClause[T]{self, -1, false}
+}
+
+// FetchFirst limits the number of the returned rows.
+func (f *FetchClause[T]) FetchFirst(count int) T {
+	f.count = count
+	f.self.Dirty()
+	return f.self
+}
+
+// WithTies returns the rows having the same ORDER BY values as the last fetched row.
+func (f *FetchClause[T]) WithTies() T {
+	f.withTies = true
+	f.self.Dirty()
+	return f.self
+}
+
+func (f *FetchClause[T]) HasFetch() bool {
+	return f.count >= 0
+}
+
+// ValidateFetch returns the error if FETCH FIRST is used together with LIMIT or WITH TIES is used without ORDER BY.
+func (f *FetchClause[T]) ValidateFetch(hasLimit bool, hasOrder bool) error {
+	if !f.HasFetch() {
+		return nil
+	}
+	if hasLimit {
+		return errors.New("FETCH FIRST cannot be used together with LIMIT")
+	}
+	if f.withTies && !hasOrder {
+		return errors.New("FETCH FIRST WITH TIES requires ORDER BY")
+	}
+	return nil
+}
+
+func (f *FetchClause[T]) CleanFetch() T {
+	f.count = -1
+	f.withTies = false
+	f.self.Dirty()
+	return f.self
+}
+
+func (f *FetchClause[T]) CopyFetch(self T) *FetchClause[T] {
+	return &FetchClause[T]{self, f.count, f.withTies}
+}
+
+func (f *FetchClause[T]) BuildFetch() T {
+	if f.count >= 0 {
+		f.self.AddSql(" FETCH FIRST " + strconv.Itoa(f.count) + " ROWS")
+		if f.withTies {
+			f.self.AddSql(" WITH TIES")
+		} else {
+			f.self.AddSql(" ONLY")
+		}
+	}
+	return f.self
+}
@@ -0,0 +1,26 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+type FromClause[T sqb.Statement[T]] struct {
+	*sql.FromClause[T]
+}
+
+func NewFromClause[T sqb.Statement[T]](self T) *FromClause[T] {
+	return &FromClause[T]{sql.NewFromClause[T](self)}
+}
+
+// FromLateral adds the LATERAL subquery or table function and its alias to the "from" clause:
+//   - FromLateral(source any)
+//   - FromLateral(source any, alias any)
+func (f *FromClause[T]) FromLateral(source any, args ...any) T {
+	return f.From(exp.Lateral(source), args...)
+}
+
+func (f *FromClause[T]) CopyFrom(self T) *FromClause[T] {
+	return &FromClause[T]{f.FromClause.CopyFrom(self)}
+}
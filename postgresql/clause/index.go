@@ -0,0 +1,311 @@
+package postgresql
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+// IndexDef is the list of index elements and the predicate of a partial index.
+// The same definition can be used to create the index and as the target of the ON CONFLICT clause,
+// the target has the columns and expressions of the index without their options.
+// The predicate of CREATE INDEX cannot contain bound parameters.
+type IndexDef struct {
+	elements  exp.ColumnListExpression
+	targets   exp.ColumnListExpression
+	predicate exp.ConditionalExpression
+	err       error
+}
+
+// NewIndexDef creates the index definition with the given columns:
+//   - NewIndexDef(columns ...any)
+func NewIndexDef(columns ...any) *IndexDef {
+	d := &IndexDef{exp.EmptyColumnListExp(), exp.EmptyColumnListExp(), exp.EmptyCondExp(), nil}
+	for _, column := range columns {
+		d.Column(column)
+	}
+	return d
+}
+
+// Column adds the column with its options to the index:
+//   - Column(column any)
+//   - Column(column any, options any), e.g. Column("created_at", "DESC NULLS LAST")
+func (d *IndexDef) Column(column any, args ...any) *IndexDef {
+	d.elements.Append(column, args...)
+	d.targets.Append(column)
+	return d
+}
+
+// Expression adds the expression with its options to the index:
+//   - Expression(expression any)
+//   - Expression(expression any, options any)
+func (d *IndexDef) Expression(expression any, args ...any) *IndexDef {
+	sql, err := ddlToString(expression)
+	if d.err == nil {
+		d.err = err
+	}
+	d.elements.Append(exp.NewExp("("+sql+")"), args...)
+	d.targets.Append(exp.NewExp("(" + sql + ")"))
+	return d
+}
+
+// Where adds "AND" or "OR" condition to the predicate of the index:
+//   - Where(condition string)
+//   - Where(condition ConditionalExpression)
+//   - Where(column string, operator string, value any)
+//   - Where(operand any, operator string, value any)
+//   - Where(operator string, operand any)
+func (d *IndexDef) Where(args ...any) *IndexDef {
+	d.predicate.Where(args...)
+	return d
+}
+
+// AndWhere adds "AND" condition to the predicate of the index.
+func (d *IndexDef) AndWhere(args ...any) *IndexDef {
+	d.predicate.AndWhere(args...)
+	return d
+}
+
+// OrWhere adds "OR" condition to the predicate of the index.
+func (d *IndexDef) OrWhere(args ...any) *IndexDef {
+	d.predicate.OrWhere(args...)
+	return d
+}
+
+func (d *IndexDef) Copy() *IndexDef {
+	return &IndexDef{d.elements.Copy(), d.targets.Copy(), d.predicate.Copy(), d.err}
+}
+
+type CreateIndexClause[T sqb.Statement[T]] struct {
+	self         T
+	name         string
+	table        exp.DirectListExpression
+	method       string
+	unique       bool
+	concurrently bool
+	ifNotExists  bool
+	only         bool
+}
+
+func NewCreateIndexClause[T sqb.Statement[T]](self T) *CreateIndexClause[T] {
+	return &CreateIndexClause[T]{self: self, table: exp.EmptyDirectListExp()}
+}
+
+func (c *CreateIndexClause[T]) Index(name string) T {
+	c.name = name
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateIndexClause[T]) On(table any) T {
+	c.table.Clean()
+	c.table.Append(table)
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateIndexClause[T]) OnOnly(table any) T {
+	c.only = true
+	return c.On(table)
+}
+
+// Using sets the index method, e.g. btree, hash, gin, gist, brin.
+func (c *CreateIndexClause[T]) Using(method string) T {
+	c.method = method
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateIndexClause[T]) Unique() T {
+	c.unique = true
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateIndexClause[T]) Concurrently() T {
+	c.concurrently = true
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateIndexClause[T]) IfNotExists() T {
+	c.ifNotExists = true
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateIndexClause[T]) CleanCreateIndex() T {
+	c.name = ""
+	c.table.Clean()
+	c.method = ""
+	c.unique = false
+	c.concurrently = false
+	c.ifNotExists = false
+	c.only = false
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *CreateIndexClause[T]) CopyCreateIndex(self T) *CreateIndexClause[T] {
+	return &CreateIndexClause[T]{
+		self,
+		c.name,
+		c.table.Copy(),
+		c.method,
+		c.unique,
+		c.concurrently,
+		c.ifNotExists,
+		c.only,
+	}
+}
+
+func (c *CreateIndexClause[T]) BuildCreateIndex() T {
+	c.self.AddSql("CREATE ")
+	if c.unique {
+		c.self.AddSql("UNIQUE ")
+	}
+	c.self.AddSql("INDEX")
+	if c.concurrently {
+		c.self.AddSql(" CONCURRENTLY")
+	}
+	if c.ifNotExists {
+		c.self.AddSql(" IF NOT EXISTS")
+	}
+	if c.name != "" {
+		c.self.AddSql(" ")
+		c.self.AddSql(c.name)
+	}
+	if c.table.IsNotEmpty() {
+		c.self.AddSql(" ON ")
+		if c.only {
+			c.self.AddSql("ONLY ")
+		}
+		c.self.AddParams(c.table.Params())
+		c.self.AddSql(c.table.String())
+	}
+	if c.method != "" {
+		c.self.AddSql(" USING ")
+		c.self.AddSql(c.method)
+	}
+	return c.self
+}
+
+type IndexDefClause[T sqb.Statement[T]] struct {
+	self    T
+	def     *IndexDef
+	include []string
+	with    string
+}
+
+func NewIndexDefClause[T sqb.Statement[T]](self T) *IndexDefClause[T] {
+	return &IndexDefClause[T]{self, NewIndexDef(), nil, ""}
+}
+
+// Definition sets the elements and predicate of the index from the index definition.
+func (i *IndexDefClause[T]) Definition(def *IndexDef) T {
+	i.def = def.Copy()
+	i.self.Dirty()
+	return i.self
+}
+
+// Column adds the column with its options to the index:
+//   - Column(column any)
+//   - Column(column any, options any)
+func (i *IndexDefClause[T]) Column(column any, args ...any) T {
+	i.def.Column(column, args...)
+	i.self.Dirty()
+	return i.self
+}
+
+// IndexExpression adds the expression with its options to the index:
+//   - IndexExpression(expression any)
+//   - IndexExpression(expression any, options any)
+func (i *IndexDefClause[T]) IndexExpression(expression any, args ...any) T {
+	i.def.Expression(expression, args...)
+	i.self.Dirty()
+	return i.self
+}
+
+func (i *IndexDefClause[T]) Include(columns ...string) T {
+	i.include = append(i.include, columns...)
+	i.self.Dirty()
+	return i.self
+}
+
+// With sets the storage parameters of the index, e.g. With("fillfactor = 70").
+func (i *IndexDefClause[T]) With(parameters string) T {
+	i.with = parameters
+	i.self.Dirty()
+	return i.self
+}
+
+// Where adds "AND" or "OR" condition to the predicate of the partial index:
+//   - Where(condition string)
+//   - Where(condition ConditionalExpression)
+//   - Where(column string, operator string, value any)
+//   - Where(operand any, operator string, value any)
+//   - Where(operator string, operand any)
+func (i *IndexDefClause[T]) Where(args ...any) T {
+	i.def.Where(args...)
+	i.self.Dirty()
+	return i.self
+}
+
+// AndWhere adds "AND" condition to the predicate of the partial index.
+func (i *IndexDefClause[T]) AndWhere(args ...any) T {
+	i.def.AndWhere(args...)
+	i.self.Dirty()
+	return i.self
+}
+
+// OrWhere adds "OR" condition to the predicate of the partial index.
+func (i *IndexDefClause[T]) OrWhere(args ...any) T {
+	i.def.OrWhere(args...)
+	i.self.Dirty()
+	return i.self
+}
+
+func (i *IndexDefClause[T]) CleanIndexDef() T {
+	i.def = NewIndexDef()
+	i.include = nil
+	i.with = ""
+	i.self.Dirty()
+	return i.self
+}
+
+func (i *IndexDefClause[T]) CopyIndexDef(self T) *IndexDefClause[T] {
+	return &IndexDefClause[T]{self, i.def.Copy(), append([]string(nil), i.include...), i.with}
+}
+
+func (i *IndexDefClause[T]) BuildIndexDef() T {
+	if i.def.err != nil {
+		sqb.Fail(i.self, i.def.err)
+	}
+	if len(i.def.predicate.Params()) > 0 {
+		sqb.Fail(i.self, errors.New("predicate of CREATE INDEX cannot have parameters, use SQL literals instead"))
+	}
+	if i.def.elements.IsNotEmpty() {
+		i.self.AddParams(i.def.elements.Params())
+		i.self.AddSql(" (")
+		i.self.AddSql(i.def.elements.String())
+		i.self.AddSql(")")
+	}
+	if len(i.include) > 0 {
+		i.self.AddSql(" INCLUDE (")
+		i.self.AddSql(strings.Join(i.include, ", "))
+		i.self.AddSql(")")
+	}
+	if i.with != "" {
+		i.self.AddSql(" WITH (")
+		i.self.AddSql(i.with)
+		i.self.AddSql(")")
+	}
+	if i.def.predicate.IsNotEmpty() {
+		i.self.AddParams(i.def.predicate.Params())
+		i.self.AddSql(" WHERE ")
+		i.self.AddSql(i.def.predicate.String())
+	}
+	return i.self
+}
@@ -41,6 +41,30 @@ func (j *JoinClause[T]) NaturalFullOuterJoin(table any, args ...any) T {
 	return j.Join("NATURAL FULL OUTER JOIN", table, args...)
 }
 
+// InnerJoinLateral adds inner join on the LATERAL subquery or table function with alias and condition:
+//   - InnerJoinLateral(source any, condition any)
+//   - InnerJoinLateral(source any, alias any, condition any)
+func (j *JoinClause[T]) InnerJoinLateral(source any, args ...any) T {
+	return j.Join("INNER JOIN LATERAL", source, args...)
+}
+
+// LeftJoinLateral adds left join on the LATERAL subquery or table function with alias and condition:
+//   - LeftJoinLateral(source any, condition any)
+//   - LeftJoinLateral(source any, alias any, condition any)
+func (j *JoinClause[T]) LeftJoinLateral(source any, args ...any) T {
+	return j.Join("LEFT JOIN LATERAL", source, args...)
+}
+
+// CrossJoinLateral adds cross join on the LATERAL subquery or table function with alias:
+//   - CrossJoinLateral(source any)
+//   - CrossJoinLateral(source any, alias any)
+func (j *JoinClause[T]) CrossJoinLateral(source any, args ...any) T {
+	if len(args) > 0 {
+		return j.Join("CROSS JOIN LATERAL", source, args[0], nil)
+	}
+	return j.Join("CROSS JOIN LATERAL", source)
+}
+
 func (j *JoinClause[T]) CopyJoin(self T) *JoinClause[T] {
 	return &JoinClause[T]{j.JoinClause.CopyJoin(self)}
 }
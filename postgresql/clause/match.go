@@ -158,11 +158,11 @@ func (m *MatchClause[T, I, U]) BuildMatch() T {
 		if match.insertStmt != nil {
 			m.self.AddSql(" ")
 			m.self.AddSql(match.insertStmt.String())
-			m.self.AddParams(match.insertStmt.Params())
+			m.self.AddParams(exp.NestedParams(match.insertStmt))
 		} else if match.updateStmt != nil {
 			m.self.AddSql(" ")
 			m.self.AddSql(match.updateStmt.String())
-			m.self.AddParams(match.updateStmt.Params())
+			m.self.AddParams(exp.NestedParams(match.updateStmt))
 		} else if match.expression != nil && match.expression.IsNotEmpty() {
 			m.self.AddSql(" ")
 			m.self.AddSql(match.expression.String())
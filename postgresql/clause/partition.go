@@ -0,0 +1,76 @@
+package postgresql
+
+import (
+	"strings"
+
+	"github.com/AlephTav/sqb"
+)
+
+type PartitionClause[T sqb.Statement[T]] struct {
+	self   T
+	by     string
+	parent string
+	bound  string
+}
+
+func NewPartitionClause[T sqb.Statement[T]](self T) *PartitionClause[T] {
+	return &PartitionClause[T]{self, "", "", ""}
+}
+
+// PartitionBy sets the partitioning of the table:
+//   - PartitionBy(strategy string, key ...string), e.g. PartitionBy("RANGE", "created_at")
+func (p *PartitionClause[T]) PartitionBy(strategy string, key ...string) T {
+	p.by = strategy + " (" + strings.Join(key, ", ") + ")"
+	p.self.Dirty()
+	return p.self
+}
+
+// PartitionOf creates the table as the partition of the parent table:
+//   - PartitionOf(parent string, bound string), e.g. PartitionOf("events", "FROM ('2024-01-01') TO ('2025-01-01')")
+//   - PartitionOf(parent string, "DEFAULT")
+func (p *PartitionClause[T]) PartitionOf(parent string, bound string) T {
+	p.parent = parent
+	p.bound = bound
+	p.self.Dirty()
+	return p.self
+}
+
+func (p *PartitionClause[T]) IsPartitionOf() bool {
+	return p.parent != ""
+}
+
+func (p *PartitionClause[T]) CleanPartition() T {
+	p.by = ""
+	p.parent = ""
+	p.bound = ""
+	p.self.Dirty()
+	return p.self
+}
+
+func (p *PartitionClause[T]) CopyPartition(self T) *PartitionClause[T] {
+	return &PartitionClause[T]{self, p.by, p.parent, p.bound}
+}
+
+func (p *PartitionClause[T]) BuildPartitionOf() T {
+	if p.parent != "" {
+		p.self.AddSql(" PARTITION OF ")
+		p.self.AddSql(p.parent)
+	}
+	return p.self
+}
+
+func (p *PartitionClause[T]) BuildPartition() T {
+	if p.parent != "" {
+		if strings.EqualFold(p.bound, "DEFAULT") {
+			p.self.AddSql(" DEFAULT")
+		} else {
+			p.self.AddSql(" FOR VALUES ")
+			p.self.AddSql(p.bound)
+		}
+	}
+	if p.by != "" {
+		p.self.AddSql(" PARTITION BY ")
+		p.self.AddSql(p.by)
+	}
+	return p.self
+}
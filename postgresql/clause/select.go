@@ -0,0 +1,89 @@
+package postgresql
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+type SelectClause[T sqb.Statement[T]] struct {
+	*sql.SelectClause[T]
+	self       T
+	distinctOn exp.ColumnListExpression
+}
+
+func NewSelectClause[T sqb.Statement[T]](self T) *SelectClause[T] {
+	return &SelectClause[T]{sql.NewSelectClause[T](self), self, exp.EmptyColumnListExp()}
+}
+
+// DistinctOn keeps only the first row of each set of rows where the given expressions evaluate to equal.
+// The ORDER BY clause must start with the same expressions:
+//   - DistinctOn(columns ...any)
+func (s *SelectClause[T]) DistinctOn(columns ...any) T {
+	for _, column := range columns {
+		s.distinctOn.Append(column)
+	}
+	return s.SelectClause.Distinct()
+}
+
+// DistinctOnItems returns the expressions of the DISTINCT ON clause.
+func (s *SelectClause[T]) DistinctOnItems() []string {
+	return exp.SplitList(s.distinctOn.String())
+}
+
+// ValidateDistinctOn checks that the initial ORDER BY expressions match the DISTINCT ON expressions.
+func (s *SelectClause[T]) ValidateDistinctOn(orderItems []string) error {
+	on := s.DistinctOnItems()
+	if len(on) == 0 {
+		return nil
+	}
+	for i := range on {
+		on[i] = normalizeExpression(on[i])
+	}
+	var matched []string
+	for _, item := range orderItems {
+		if len(matched) == len(on) {
+			break
+		}
+		item = normalizeExpression(orderDirectionRegexp.ReplaceAllString(item, ""))
+		if !slices.Contains(on, item) {
+			return fmt.Errorf(
+				"SELECT DISTINCT ON expressions must match initial ORDER BY expressions, %q is not in DISTINCT ON (%s)",
+				item,
+				strings.Join(on, ", "),
+			)
+		}
+		if !slices.Contains(matched, item) {
+			matched = append(matched, item)
+		}
+	}
+	return nil
+}
+
+var orderDirectionRegexp = regexp.MustCompile(`(?i)(\s+(ASC|DESC|USING\s+\S+))?(\s+NULLS\s+(FIRST|LAST))?\s*$`)
+
+func normalizeExpression(expression string) string {
+	return strings.Join(strings.Fields(expression), " ")
+}
+
+func (s *SelectClause[T]) CleanSelect() T {
+	s.distinctOn.Clean()
+	return s.SelectClause.CleanSelect()
+}
+
+func (s *SelectClause[T]) CopySelect(self T) *SelectClause[T] {
+	return &SelectClause[T]{s.SelectClause.CopySelect(self), self, s.distinctOn.Copy()}
+}
+
+func (s *SelectClause[T]) BuildSelect() T {
+	if s.distinctOn.IsEmpty() {
+		return s.SelectClause.BuildSelect()
+	}
+	s.self.AddParams(s.distinctOn.Params())
+	return s.SelectClause.BuildSelectWith("ON (" + s.distinctOn.String() + ")")
+}
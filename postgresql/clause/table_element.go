@@ -0,0 +1,335 @@
+package postgresql
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+// ColumnDef is the column definition of CREATE TABLE and ALTER TABLE statements.
+// Defaults, generation and check expressions are SQL, they are not bound as parameters.
+type ColumnDef struct {
+	name        string
+	dataType    string
+	constraints []string
+	constraint  string
+	references  int
+	err         error
+}
+
+func NewColumnDef(name string, dataType string) *ColumnDef {
+	return &ColumnDef{name: name, dataType: dataType, references: -1}
+}
+
+// Constraint names the next constraint of the column.
+func (c *ColumnDef) Constraint(name string) *ColumnDef {
+	c.constraint = name
+	return c
+}
+
+func (c *ColumnDef) Collate(collation string) *ColumnDef {
+	return c.add("COLLATE " + collation)
+}
+
+func (c *ColumnDef) NotNull() *ColumnDef {
+	return c.add("NOT NULL")
+}
+
+func (c *ColumnDef) Null() *ColumnDef {
+	return c.add("NULL")
+}
+
+func (c *ColumnDef) Default(value any) *ColumnDef {
+	return c.add("DEFAULT " + c.ddl(value))
+}
+
+// GeneratedAlwaysAs makes the column a stored generated column.
+func (c *ColumnDef) GeneratedAlwaysAs(expression any) *ColumnDef {
+	return c.add("GENERATED ALWAYS AS (" + c.ddl(expression) + ") STORED")
+}
+
+// GeneratedAsIdentity makes the column an identity column:
+//   - GeneratedAsIdentity() adds GENERATED ALWAYS AS IDENTITY
+//   - GeneratedAsIdentity(always bool)
+func (c *ColumnDef) GeneratedAsIdentity(args ...bool) *ColumnDef {
+	if len(args) > 0 && !args[0] {
+		return c.add("GENERATED BY DEFAULT AS IDENTITY")
+	}
+	return c.add("GENERATED ALWAYS AS IDENTITY")
+}
+
+func (c *ColumnDef) PrimaryKey() *ColumnDef {
+	return c.add("PRIMARY KEY")
+}
+
+func (c *ColumnDef) Unique() *ColumnDef {
+	return c.add("UNIQUE")
+}
+
+func (c *ColumnDef) Check(condition any) *ColumnDef {
+	return c.add("CHECK (" + c.ddl(condition) + ")")
+}
+
+// References adds the foreign key constraint to the column:
+//   - References(table string)
+//   - References(table string, column string)
+func (c *ColumnDef) References(table string, args ...string) *ColumnDef {
+	c.add("REFERENCES " + table + columnsToString(args))
+	c.references = len(c.constraints) - 1
+	return c
+}
+
+// OnDelete adds the referential action to the last REFERENCES constraint.
+func (c *ColumnDef) OnDelete(action string) *ColumnDef {
+	return c.appendToReferences("ON DELETE " + action)
+}
+
+// OnUpdate adds the referential action to the last REFERENCES constraint.
+func (c *ColumnDef) OnUpdate(action string) *ColumnDef {
+	return c.appendToReferences("ON UPDATE " + action)
+}
+
+func (c *ColumnDef) add(constraint string) *ColumnDef {
+	if c.constraint != "" {
+		constraint = "CONSTRAINT " + c.constraint + " " + constraint
+		c.constraint = ""
+	}
+	c.constraints = append(c.constraints, constraint)
+	return c
+}
+
+func (c *ColumnDef) appendToReferences(action string) *ColumnDef {
+	if c.references < 0 {
+		c.fail(errors.New("referential action " + action + " of column " + c.name + " requires REFERENCES constraint"))
+	} else {
+		c.constraints[c.references] += " " + action
+	}
+	return c
+}
+
+func (c *ColumnDef) ddl(value any) string {
+	sql, err := exp.DdlToString(value)
+	c.fail(err)
+	return sql
+}
+
+func (c *ColumnDef) fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *ColumnDef) String() string {
+	var result strings.Builder
+	result.WriteString(c.name)
+	if c.dataType != "" {
+		result.WriteByte(' ')
+		result.WriteString(c.dataType)
+	}
+	for _, constraint := range c.constraints {
+		result.WriteByte(' ')
+		result.WriteString(constraint)
+	}
+	return result.String()
+}
+
+// TableConstraint is the table constraint of CREATE TABLE and ALTER TABLE statements.
+type TableConstraint struct {
+	name    string
+	def     string
+	options []string
+	err     error
+}
+
+func PrimaryKey(columns ...string) *TableConstraint {
+	return &TableConstraint{def: "PRIMARY KEY" + columnsToString(columns)}
+}
+
+func Unique(columns ...string) *TableConstraint {
+	return &TableConstraint{def: "UNIQUE" + columnsToString(columns)}
+}
+
+func Check(condition any) *TableConstraint {
+	sql, err := exp.DdlToString(condition)
+	return &TableConstraint{def: "CHECK (" + sql + ")", err: err}
+}
+
+// ForeignKey creates the foreign key constraint, the referenced table is set by References.
+func ForeignKey(columns ...string) *TableConstraint {
+	return &TableConstraint{def: "FOREIGN KEY" + columnsToString(columns)}
+}
+
+// Exclude creates the exclusion constraint:
+//   - Exclude(method string, elements ...string), e.g. Exclude("gist", "room WITH =", "during WITH &&")
+func Exclude(method string, elements ...string) *TableConstraint {
+	return &TableConstraint{def: "EXCLUDE USING " + method + columnsToString(elements)}
+}
+
+func (c *TableConstraint) Named(name string) *TableConstraint {
+	c.name = name
+	return c
+}
+
+// References sets the referenced table and columns of the foreign key constraint.
+func (c *TableConstraint) References(table string, columns ...string) *TableConstraint {
+	c.def += " REFERENCES " + table + columnsToString(columns)
+	return c
+}
+
+func (c *TableConstraint) OnDelete(action string) *TableConstraint {
+	return c.Option("ON DELETE " + action)
+}
+
+func (c *TableConstraint) OnUpdate(action string) *TableConstraint {
+	return c.Option("ON UPDATE " + action)
+}
+
+// Include adds the non-key columns to the unique, primary key or exclusion constraint index.
+func (c *TableConstraint) Include(columns ...string) *TableConstraint {
+	return c.Option("INCLUDE" + columnsToString(columns))
+}
+
+// Where adds the predicate to the exclusion constraint.
+func (c *TableConstraint) Where(condition any) *TableConstraint {
+	sql, err := exp.DdlToString(condition)
+	if c.err == nil {
+		c.err = err
+	}
+	return c.Option("WHERE (" + sql + ")")
+}
+
+func (c *TableConstraint) Deferrable(initiallyDeferred bool) *TableConstraint {
+	if initiallyDeferred {
+		return c.Option("DEFERRABLE INITIALLY DEFERRED")
+	}
+	return c.Option("DEFERRABLE INITIALLY IMMEDIATE")
+}
+
+// Option appends the raw option to the constraint.
+func (c *TableConstraint) Option(option string) *TableConstraint {
+	c.options = append(c.options, option)
+	return c
+}
+
+func (c *TableConstraint) String() string {
+	var result strings.Builder
+	if c.name != "" {
+		result.WriteString("CONSTRAINT ")
+		result.WriteString(c.name)
+		result.WriteByte(' ')
+	}
+	result.WriteString(c.def)
+	for _, option := range c.options {
+		result.WriteByte(' ')
+		result.WriteString(option)
+	}
+	return result.String()
+}
+
+func columnsToString(columns []string) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(columns, ", ") + ")"
+}
+
+// ddlToString returns the SQL of the column definition, table constraint or other value of DDL statement,
+// see exp.DdlToString.
+func ddlToString(value any) (string, error) {
+	switch value.(type) {
+	case *ColumnDef:
+		return value.(*ColumnDef).String(), value.(*ColumnDef).err
+	case *TableConstraint:
+		return value.(*TableConstraint).String(), value.(*TableConstraint).err
+	default:
+		return exp.DdlToString(value)
+	}
+}
+
+// TableElementsClause is the list of columns and constraints of the CREATE TABLE statement.
+type TableElementsClause[T sqb.Statement[T]] struct {
+	self     T
+	elements []string
+	err      error
+}
+
+func NewTableElementsClause[T sqb.Statement[T]](self T) *TableElementsClause[T] {
+	return &TableElementsClause[T]{self, nil, nil}
+}
+
+// Column adds the column definition to the table:
+//   - Column(column *ColumnDef)
+//   - Column(name string, dataType string, constraints ...string)
+func (e *TableElementsClause[T]) Column(column any, args ...any) T {
+	sql, err := columnDefToString(column, args)
+	e.add(sql, err)
+	return e.self
+}
+
+// Constraint adds the table constraint:
+//   - Constraint(constraint *TableConstraint)
+//   - Constraint(constraint string)
+func (e *TableElementsClause[T]) Constraint(constraint any) T {
+	e.add(ddlToString(constraint))
+	return e.self
+}
+
+// Like copies the column definitions of the table:
+//   - Like(table string)
+//   - Like(table string, options string), e.g. Like("tb", "INCLUDING ALL")
+func (e *TableElementsClause[T]) Like(table string, args ...string) T {
+	like := "LIKE " + table
+	if len(args) > 0 && args[0] != "" {
+		like += " " + args[0]
+	}
+	e.add(like, nil)
+	return e.self
+}
+
+func (e *TableElementsClause[T]) add(element string, err error) {
+	e.elements = append(e.elements, element)
+	if e.err == nil {
+		e.err = err
+	}
+	e.self.Dirty()
+}
+
+func (e *TableElementsClause[T]) HasTableElements() bool {
+	return len(e.elements) > 0
+}
+
+func (e *TableElementsClause[T]) CleanTableElements() T {
+	e.elements = nil
+	e.err = nil
+	e.self.Dirty()
+	return e.self
+}
+
+func (e *TableElementsClause[T]) CopyTableElements(self T) *TableElementsClause[T] {
+	return &TableElementsClause[T]{self, append([]string(nil), e.elements...), e.err}
+}
+
+func (e *TableElementsClause[T]) BuildTableElements() T {
+	if e.err != nil {
+		sqb.Fail(e.self, e.err)
+	}
+	e.self.AddSql(" (")
+	e.self.AddSql(strings.Join(e.elements, ", "))
+	e.self.AddSql(")")
+	return e.self
+}
+
+func columnDefToString(column any, args []any) (string, error) {
+	var result error
+	items := make([]string, 0, len(args)+1)
+	for _, item := range append([]any{column}, args...) {
+		sql, err := ddlToString(item)
+		if result == nil {
+			result = err
+		}
+		items = append(items, sql)
+	}
+	return strings.Join(items, " "), result
+}
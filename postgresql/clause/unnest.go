@@ -0,0 +1,167 @@
+package postgresql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+	exp "github.com/AlephTav/sqb/sql/expression"
+)
+
+// UnnestArrays is the list of columns whose values are passed as array parameters cast to the column types.
+// The number of parameters equals the number of columns and does not depend on the number of rows.
+type UnnestArrays struct {
+	columns []string
+	arrays  []string
+	params  map[string]any
+	err     error
+}
+
+// NewUnnestArrays creates the array parameters of the columns from row-oriented or columnar data:
+//   - NewUnnestArrays(types sqb.SliceMap, rows []map[string]any)
+//   - NewUnnestArrays(types sqb.SliceMap, rows []sqb.SliceMap)
+//   - NewUnnestArrays(types sqb.SliceMap, columns map[string]any), the values of the columns are slices
+//   - NewUnnestArrays(types sqb.SliceMap, columns sqb.SliceMap)
+//
+// The types map the columns to their SQL types, e.g. sqb.Map("id", "int", "name", "text"),
+// they also define the order of the columns. The missing values are NULL, the columns missing from the types
+// make Err return the error.
+func NewUnnestArrays(types sqb.SliceMap, data any) *UnnestArrays {
+	return newUnnestArrays(types, data, false)
+}
+
+// NewStrictUnnestArrays creates the array parameters like NewUnnestArrays, but every row must have all columns
+// of the types, otherwise Err returns the error. It is used to update rows, where a missing value would set NULL.
+func NewStrictUnnestArrays(types sqb.SliceMap, data any) *UnnestArrays {
+	return newUnnestArrays(types, data, true)
+}
+
+func newUnnestArrays(types sqb.SliceMap, data any, strict bool) *UnnestArrays {
+	columns := make([]string, 0, len(types)/2)
+	for i := 0; i < len(types); i += 2 {
+		columns = append(columns, fmt.Sprint(types[i]))
+	}
+	values, err := unnestValues(columns, data, strict)
+	u := &UnnestArrays{columns, make([]string, 0, len(columns)), make(map[string]any, len(columns)), err}
+	for i, column := range columns {
+		name := sqb.NextParameterName()
+		u.params[name] = values[column]
+		u.arrays = append(u.arrays, ":"+name+"::"+fmt.Sprint(types[2*i+1])+"[]")
+	}
+	return u
+}
+
+func unnestValues(columns []string, data any, strict bool) (map[string]any, error) {
+	values := make(map[string]any, len(columns))
+	switch data.(type) {
+	case map[string]any:
+		columnar := data.(map[string]any)
+		for column := range columnar {
+			if !slices.Contains(columns, column) {
+				return values, unknownUnnestColumn(column, columns)
+			}
+		}
+		for _, column := range columns {
+			if _, exists := columnar[column]; !exists && strict {
+				return values, missingUnnestColumn(column, -1)
+			}
+			values[column] = columnar[column]
+		}
+	case sqb.SliceMap:
+		columnar := data.(sqb.SliceMap)
+		for i := 0; i+1 < len(columnar); i += 2 {
+			column := fmt.Sprint(columnar[i])
+			if !slices.Contains(columns, column) {
+				return values, unknownUnnestColumn(column, columns)
+			}
+			values[column] = columnar[i+1]
+		}
+		for _, column := range columns {
+			if _, exists := values[column]; !exists && strict {
+				return values, missingUnnestColumn(column, -1)
+			}
+		}
+	case []map[string]any:
+		rows := data.([]map[string]any)
+		for i, row := range rows {
+			for column := range row {
+				if !slices.Contains(columns, column) {
+					return values, unknownUnnestColumn(column, columns)
+				}
+			}
+			for _, column := range columns {
+				if _, exists := row[column]; !exists && strict {
+					return values, missingUnnestColumn(column, i)
+				}
+			}
+		}
+		for _, column := range columns {
+			array := make([]any, len(rows))
+			for i, row := range rows {
+				array[i] = row[column]
+			}
+			values[column] = array
+		}
+	case []sqb.SliceMap:
+		rows := data.([]sqb.SliceMap)
+		for _, column := range columns {
+			values[column] = make([]any, len(rows))
+		}
+		for i, row := range rows {
+			found := make(map[string]bool, len(columns))
+			for j := 0; j+1 < len(row); j += 2 {
+				array, exists := values[fmt.Sprint(row[j])]
+				if !exists {
+					return values, unknownUnnestColumn(fmt.Sprint(row[j]), columns)
+				}
+				array.([]any)[i] = row[j+1]
+				found[fmt.Sprint(row[j])] = true
+			}
+			for _, column := range columns {
+				if !found[column] && strict {
+					return values, missingUnnestColumn(column, i)
+				}
+			}
+		}
+	default:
+		return values, fmt.Errorf("unsupported unnest data of type %T", data)
+	}
+	return values, nil
+}
+
+func unknownUnnestColumn(column string, columns []string) error {
+	return fmt.Errorf("unnest data has column %q missing from the types of columns %v", column, columns)
+}
+
+func missingUnnestColumn(column string, row int) error {
+	if row < 0 {
+		return fmt.Errorf("unnest data has no column %q", column)
+	}
+	return fmt.Errorf("unnest row %d has no column %q", row, column)
+}
+
+func (u *UnnestArrays) Columns() []string {
+	return u.columns
+}
+
+// Err returns the error of the data having the columns missing from the types
+// or, for strict arrays, the columns of the types missing from the data.
+func (u *UnnestArrays) Err() error {
+	return u.err
+}
+
+// Function returns the call of unnest expanding all arrays, e.g. unnest(:p1::int[], :p2::text[]).
+func (u *UnnestArrays) Function() exp.Expression {
+	return exp.NewExpWithParams("unnest("+strings.Join(u.arrays, ", ")+")", u.params)
+}
+
+// Select returns the select list expanding every array to the column with the same name,
+// e.g. SELECT unnest(:p1::int[]) AS id, unnest(:p2::text[]) AS name.
+func (u *UnnestArrays) Select() exp.Expression {
+	items := make([]string, 0, len(u.columns))
+	for i, column := range u.columns {
+		items = append(items, "unnest("+u.arrays[i]+") AS "+column)
+	}
+	return exp.NewExpWithParams("SELECT "+strings.Join(items, ", "), u.params)
+}
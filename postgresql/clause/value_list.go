@@ -3,6 +3,7 @@ package postgresql
 import (
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
 )
 
 type ValueListClause[T sqb.ColumnsAwareStmt[T], Q sqb.QueryStmt[Q]] struct {
@@ -18,17 +19,17 @@ func (v *ValueListClause[T, Q]) CopyValueList(self T) *ValueListClause[T, Q] {
 }
 
 func (v *ValueListClause[T, Q]) BuildValueList() T {
-	self, query, exp := v.ValueListClause.BuildValueList()
+	self, query, values := v.ValueListClause.BuildValueList()
 	if query != nil {
-		self.AddParams((*query).Params())
+		self.AddParams(exp.NestedParams(*query))
 		self.AddSql(" ")
 		self.AddSql((*query).String())
-	} else if exp.IsEmpty() {
+	} else if values.IsEmpty() {
 		self.AddSql(" DEFAULT VALUES")
 	} else {
-		self.AddParams(exp.Params())
+		self.AddParams(values.Params())
 		self.AddSql(" VALUES ")
-		self.AddSql(exp.String())
+		self.AddSql(values.String())
 	}
 	return self
 }
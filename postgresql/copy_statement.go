@@ -0,0 +1,81 @@
+package postgresql
+
+import (
+	"errors"
+
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/execution"
+	postgresql "github.com/AlephTav/sqb/postgresql/clause"
+	"github.com/AlephTav/sqb/sql"
+	cls "github.com/AlephTav/sqb/sql/clause"
+)
+
+// CopyStmt is the COPY statement, it loads large amounts of data without the limit of the number of parameters.
+// The data of STDIN and STDOUT is streamed by the executor implementing sqb.CopyExecutor.
+type CopyStmt struct {
+	*execution.StatementExecution[*CopyStmt]
+	*execution.CopyExecution[*CopyStmt]
+	*sql.BaseStatement[*CopyStmt]
+	*postgresql.CopyClause[*CopyStmt]
+	*cls.ColumnsClause[*CopyStmt]
+	*postgresql.CopyOptionsClause[*CopyStmt]
+	*cls.WhereClause[*CopyStmt]
+}
+
+func NewCopyStmt(db sqb.StatementExecutor) *CopyStmt {
+	st := &CopyStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*CopyStmt](st)
+	st.CopyExecution = execution.NewCopyExecution[*CopyStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CopyStmt](st, db)
+	st.CopyClause = postgresql.NewCopyClause[*CopyStmt](st)
+	st.ColumnsClause = cls.NewColumnsClause[*CopyStmt](st)
+	st.CopyOptionsClause = postgresql.NewCopyOptionsClause[*CopyStmt](st)
+	st.WhereClause = cls.NewWhereClause[*CopyStmt](st)
+	return st
+}
+
+func (s *CopyStmt) ItIsCommand() {}
+
+func (s *CopyStmt) Clean() *CopyStmt {
+	s.CleanCopy()
+	s.CleanColumns()
+	s.CleanCopyOptions()
+	s.CleanWhere()
+	return s
+}
+
+func (s *CopyStmt) Copy() *CopyStmt {
+	st := &CopyStmt{}
+	st.CopyClause = s.CopyCopy(st)
+	st.ColumnsClause = s.CopyColumns(st)
+	st.CopyOptionsClause = s.CopyCopyOptions(st)
+	st.WhereClause = s.CopyWhere(st)
+	st.StatementExecution = execution.NewStatementExecution[*CopyStmt](st)
+	st.CopyExecution = execution.NewCopyExecution[*CopyStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CopyStmt](st, s.Executor())
+	return st
+}
+
+func (s *CopyStmt) Build() *CopyStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildCopy()
+	s.BuildColumns()
+	s.BuildCopyDirection()
+	s.BuildCopyOptions()
+	if s.IsCopyFrom() {
+		s.BuildWhere()
+	} else if s.HasWhere() {
+		s.Fail(errors.New("COPY TO cannot have WHERE condition, use the query instead"))
+	}
+	if s.HasQuery() && s.HasColumns() {
+		s.Fail(errors.New("COPY of the query cannot have the column list"))
+	}
+	if s.HasQuery() && s.IsCopyFrom() {
+		s.Fail(errors.New("COPY FROM requires the table instead of the query"))
+	}
+	s.Built()
+	return s
+}
@@ -0,0 +1,193 @@
+package postgresql
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/AlephTav/sqb"
+)
+
+type copyExecutorMock struct {
+	sqb.StatementExecutorMock
+	sql  string
+	data string
+	rows [][]any
+}
+
+func (m *copyExecutorMock) CopyFrom(sql string, source io.Reader) (int64, error) {
+	m.sql = sql
+	data, err := io.ReadAll(source)
+	m.data = string(data)
+	return int64(strings.Count(m.data, "\n")), err
+}
+
+func (m *copyExecutorMock) CopyFromRows(sql string, source sqb.RowSource) (int64, error) {
+	m.sql = sql
+	for source.Next() {
+		values, err := source.Values()
+		if err != nil {
+			return 0, err
+		}
+		m.rows = append(m.rows, values)
+	}
+	return int64(len(m.rows)), source.Err()
+}
+
+func (m *copyExecutorMock) CopyTo(sql string, target io.Writer) (int64, error) {
+	m.sql = sql
+	n, err := io.WriteString(target, "1,a\n2,b\n")
+	return int64(n), err
+}
+
+func TestCopyStmt_EmptyCopy(t *testing.T) {
+	st := NewCopyStmt(nil)
+
+	sqb.CheckSql(t, "COPY ", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCopyStmt_FromStdin(t *testing.T) {
+	st := NewCopyStmt(nil).
+		Table("users").
+		Columns("id, name, email").
+		FromStdin().
+		CSV().
+		Delimiter(";").
+		Header().
+		Null("").
+		Where("id > 100")
+
+	sqb.CheckSql(
+		t,
+		"COPY users (id, name, email) FROM STDIN WITH (FORMAT csv, DELIMITER ';', HEADER true, NULL '') WHERE id > 100",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCopyStmt_ToStdout(t *testing.T) {
+	st := NewCopyStmt(nil).
+		Query(NewSelectStmt(nil).From("users").Where("active")).
+		ToStdout().
+		CSV().
+		Header().
+		ForceQuote("*")
+
+	sqb.CheckSql(t, "COPY (SELECT * FROM users WHERE active) TO STDOUT WITH (FORMAT csv, HEADER true, FORCE_QUOTE *)", st.String())
+}
+
+func TestCopyStmt_Files(t *testing.T) {
+	st := NewCopyStmt(nil).
+		Table("logs").
+		FromFile("/tmp/it's.csv").
+		Format("csv").
+		Header("MATCH").
+		Quote(`"`).
+		Escape(`\`).
+		Encoding("UTF8").
+		ForceNotNull("a", "b").
+		ForceNull("c").
+		Freeze()
+
+	sqb.CheckSql(
+		t,
+		`COPY logs FROM '/tmp/it''s.csv' WITH (FORMAT csv, HEADER MATCH, QUOTE '"', ESCAPE '\', `+
+			`ENCODING 'UTF8', FORCE_NOT_NULL (a, b), FORCE_NULL (c), FREEZE true)`,
+		st.String(),
+	)
+
+	st.Clean().Table("logs").ToProgram("gzip > /tmp/logs.gz").Binary().Option("ON_ERROR", "ignore")
+
+	sqb.CheckSql(t, "COPY logs TO PROGRAM 'gzip > /tmp/logs.gz' WITH (FORMAT binary, ON_ERROR ignore)", st.String())
+}
+
+func TestCopyStmt_Copy(t *testing.T) {
+	origin := NewCopyStmt(nil).Table("tb").FromStdin()
+	copied := origin.Copy().Columns("a").CSV()
+
+	sqb.CheckSql(t, "COPY tb FROM STDIN", origin.String())
+	sqb.CheckSql(t, "COPY tb (a) FROM STDIN WITH (FORMAT csv)", copied.String())
+}
+
+func TestCopyStmt_CopyFrom(t *testing.T) {
+	db := &copyExecutorMock{}
+	n, err := NewCopyStmt(db).Table("tb").Columns("a, b").FromStdin().CSV().CopyFrom(strings.NewReader("1,a\n2,b\n"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 || db.data != "1,a\n2,b\n" {
+		t.Errorf("Unexpected copied data %q", db.data)
+	}
+	sqb.CheckSql(t, "COPY tb (a, b) FROM STDIN WITH (FORMAT csv)", db.sql)
+}
+
+func TestCopyStmt_CopyFromRows(t *testing.T) {
+	db := &copyExecutorMock{}
+	n := NewCopyStmt(db).
+		Table("tb").
+		Columns("a, b").
+		FromStdin().
+		Binary().
+		MustCopyFromRows(sqb.RowsFromSlice([][]any{{1, "a"}, {2, "b"}, {3, nil}}))
+
+	if n != 3 || len(db.rows) != 3 || db.rows[2][0] != 3 {
+		t.Errorf("Unexpected copied rows %v", db.rows)
+	}
+	sqb.CheckSql(t, "COPY tb (a, b) FROM STDIN WITH (FORMAT binary)", db.sql)
+}
+
+func TestCopyStmt_CopyTo(t *testing.T) {
+	db := &copyExecutorMock{}
+	var out bytes.Buffer
+	_, err := NewCopyStmt(db).Table("tb").ToStdout().CSV().CopyTo(&out)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "1,a\n2,b\n" {
+		t.Errorf("Unexpected output %q", out.String())
+	}
+	sqb.CheckSql(t, "COPY tb TO STDOUT WITH (FORMAT csv)", db.sql)
+}
+
+func TestCopyStmt_CopyErrors(t *testing.T) {
+	_, err := NewCopyStmt(sqb.NewStatementExecutorMock()).Table("tb").FromStdin().CopyFrom(strings.NewReader(""))
+	if err == nil || err.Error() != "statement executor does not support streaming of COPY data" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, err = NewCopyStmt(&copyExecutorMock{}).Table("tb").FromStdin().Where("a", "=", 1).CopyFrom(strings.NewReader(""))
+	if err == nil || err.Error() != "COPY statement cannot have parameters" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestCopyStmt_InvalidCopy(t *testing.T) {
+	query := NewSelectStmt(nil).From("users")
+	statements := map[string]*CopyStmt{
+		"COPY TO cannot have WHERE condition, use the query instead": NewCopyStmt(nil).
+			Table("users").
+			ToStdout().
+			Where("active"),
+		"COPY of the query cannot have the column list": NewCopyStmt(nil).
+			Query(query).
+			Columns("id").
+			ToStdout(),
+		"COPY FROM requires the table instead of the query": NewCopyStmt(nil).
+			Query(query).
+			FromStdin(),
+	}
+	for expected, st := range statements {
+		if err := st.Validate(); err == nil || err.Error() != expected {
+			t.Errorf("Unexpected error %v, expected %q", err, expected)
+		}
+	}
+
+	_, err := NewCopyStmt(&copyExecutorMock{}).Table("tb").ToStdout().Where("a > 1").CopyTo(io.Discard)
+	if err == nil || err.Error() != "COPY TO cannot have WHERE condition, use the query instead" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
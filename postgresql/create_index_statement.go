@@ -0,0 +1,52 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/execution"
+	postgresql "github.com/AlephTav/sqb/postgresql/clause"
+	"github.com/AlephTav/sqb/sql"
+)
+
+type CreateIndexStmt struct {
+	*execution.StatementExecution[*CreateIndexStmt]
+	*sql.BaseStatement[*CreateIndexStmt]
+	*postgresql.CreateIndexClause[*CreateIndexStmt]
+	*postgresql.IndexDefClause[*CreateIndexStmt]
+}
+
+func NewCreateIndexStmt(db sqb.StatementExecutor) *CreateIndexStmt {
+	st := &CreateIndexStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*CreateIndexStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateIndexStmt](st, db)
+	st.CreateIndexClause = postgresql.NewCreateIndexClause[*CreateIndexStmt](st)
+	st.IndexDefClause = postgresql.NewIndexDefClause[*CreateIndexStmt](st)
+	return st
+}
+
+func (s *CreateIndexStmt) ItIsCommand() {}
+
+func (s *CreateIndexStmt) Clean() *CreateIndexStmt {
+	s.CleanCreateIndex()
+	s.CleanIndexDef()
+	return s
+}
+
+func (s *CreateIndexStmt) Copy() *CreateIndexStmt {
+	st := &CreateIndexStmt{}
+	st.CreateIndexClause = s.CopyCreateIndex(st)
+	st.IndexDefClause = s.CopyIndexDef(st)
+	st.StatementExecution = execution.NewStatementExecution[*CreateIndexStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateIndexStmt](st, s.Executor())
+	return st
+}
+
+func (s *CreateIndexStmt) Build() *CreateIndexStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildCreateIndex()
+	s.BuildIndexDef()
+	s.Built()
+	return s
+}
@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/postgresql/clause"
+	sql "github.com/AlephTav/sqb/sql/expression"
+	"testing"
+)
+
+func TestCreateIndexStmt_EmptyCreateIndex(t *testing.T) {
+	st := NewCreateIndexStmt(nil)
+
+	sqb.CheckSql(t, "CREATE INDEX", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCreateIndexStmt_Columns(t *testing.T) {
+	st := NewCreateIndexStmt(nil).
+		Index("users_name_idx").
+		On("users").
+		Column("last_name").
+		Column("created_at", "DESC NULLS LAST")
+
+	sqb.CheckSql(t, "CREATE INDEX users_name_idx ON users (last_name, created_at DESC NULLS LAST)", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCreateIndexStmt_UniqueConcurrently(t *testing.T) {
+	st := NewCreateIndexStmt(nil).
+		Unique().
+		Concurrently().
+		IfNotExists().
+		Index("users_email_key").
+		OnOnly("users").
+		Using("btree").
+		IndexExpression("lower(email)").
+		Include("id", "name").
+		With("fillfactor = 70")
+
+	sqb.CheckSql(
+		t,
+		"CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS users_email_key ON ONLY users USING btree "+
+			"((lower(email))) INCLUDE (id, name) WITH (fillfactor = 70)",
+		st.String(),
+	)
+}
+
+func TestCreateIndexStmt_Gin(t *testing.T) {
+	st := NewCreateIndexStmt(nil).
+		On("documents").
+		Using("gin").
+		Column("data", "jsonb_path_ops")
+
+	sqb.CheckSql(t, "CREATE INDEX ON documents USING gin (data jsonb_path_ops)", st.String())
+}
+
+func TestCreateIndexStmt_PartialIndex(t *testing.T) {
+	st := NewCreateIndexStmt(nil).
+		Index("orders_open_idx").
+		On("orders").
+		Using("brin").
+		Column("created_at").
+		Where("closed_at IS NULL").
+		AndWhere(sql.NewCondExp("status = 'new'").OrWhere("status = 'paid'"))
+
+	sqb.CheckSql(
+		t,
+		"CREATE INDEX orders_open_idx ON orders USING brin (created_at) WHERE closed_at IS NULL AND (status = 'new' OR status = 'paid')",
+		st.String(),
+	)
+}
+
+func TestCreateIndexStmt_SharedDefinition(t *testing.T) {
+	sqb.ResetParameterIndex()
+	def := postgresql.NewIndexDef("tenant_id").Expression("lower(email)", "DESC NULLS LAST").Where("deleted_at IS NULL")
+	index := NewCreateIndexStmt(nil).
+		Unique().
+		Index("users_email_key").
+		On("users").
+		Definition(def)
+	insert := NewInsertStmt(nil).
+		Into("users").
+		Values(sqb.Map("tenant_id", 1, "email", "a@b.c")).
+		OnConflictIndex(def).
+		DoNothing()
+
+	sqb.CheckSql(
+		t,
+		"CREATE UNIQUE INDEX users_email_key ON users (tenant_id, (lower(email)) DESC NULLS LAST) WHERE deleted_at IS NULL",
+		index.String(),
+	)
+	sqb.CheckSql(
+		t,
+		"INSERT INTO users (tenant_id, email) VALUES (:p1, :p2) ON CONFLICT (tenant_id, (lower(email))) WHERE deleted_at IS NULL DO NOTHING",
+		insert.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": "a@b.c"}, insert.Params())
+}
+
+func TestCreateIndexStmt_PredicateWithParameters(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewCreateIndexStmt(sqb.NewStatementExecutorMock()).
+		On("orders").
+		Column("created_at").
+		Where("status", "=", "new")
+
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about parameters of index predicate")
+	}
+	if _, err := st.Exec(); err == nil {
+		t.Error("Exec() error is nil, expected the error about parameters of index predicate")
+	}
+}
+
+func TestCreateIndexStmt_Copy(t *testing.T) {
+	origin := NewCreateIndexStmt(nil).On("tb").Column("a")
+	copied := origin.Copy().Column("b").Where("b > 0")
+
+	sqb.CheckSql(t, "CREATE INDEX ON tb (a)", origin.String())
+	sqb.CheckSql(t, "CREATE INDEX ON tb (a, b) WHERE b > 0", copied.String())
+}
+
+func TestCreateIndexStmt_Clean(t *testing.T) {
+	st := NewCreateIndexStmt(nil).Unique().On("tb").Column("a").Where("a > 0").Clean()
+
+	sqb.CheckSql(t, "CREATE INDEX", st.String())
+}
@@ -0,0 +1,60 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/execution"
+	postgresql "github.com/AlephTav/sqb/postgresql/clause"
+	"github.com/AlephTav/sqb/sql"
+)
+
+type CreateTableStmt struct {
+	*execution.StatementExecution[*CreateTableStmt]
+	*sql.BaseStatement[*CreateTableStmt]
+	*postgresql.CreateTableClause[*CreateTableStmt]
+	*postgresql.TableElementsClause[*CreateTableStmt]
+	*postgresql.PartitionClause[*CreateTableStmt]
+}
+
+func NewCreateTableStmt(db sqb.StatementExecutor) *CreateTableStmt {
+	st := &CreateTableStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*CreateTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateTableStmt](st, db)
+	st.CreateTableClause = postgresql.NewCreateTableClause[*CreateTableStmt](st)
+	st.TableElementsClause = postgresql.NewTableElementsClause[*CreateTableStmt](st)
+	st.PartitionClause = postgresql.NewPartitionClause[*CreateTableStmt](st)
+	return st
+}
+
+func (s *CreateTableStmt) ItIsCommand() {}
+
+func (s *CreateTableStmt) Clean() *CreateTableStmt {
+	s.CleanCreateTable()
+	s.CleanTableElements()
+	s.CleanPartition()
+	return s
+}
+
+func (s *CreateTableStmt) Copy() *CreateTableStmt {
+	st := &CreateTableStmt{}
+	st.CreateTableClause = s.CopyCreateTable(st)
+	st.TableElementsClause = s.CopyTableElements(st)
+	st.PartitionClause = s.CopyPartition(st)
+	st.StatementExecution = execution.NewStatementExecution[*CreateTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*CreateTableStmt](st, s.Executor())
+	return st
+}
+
+func (s *CreateTableStmt) Build() *CreateTableStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildCreateTable()
+	s.BuildPartitionOf()
+	if s.HasTable() && (s.HasTableElements() || !s.IsPartitionOf()) {
+		s.BuildTableElements()
+	}
+	s.BuildPartition()
+	s.Built()
+	return s
+}
@@ -0,0 +1,206 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/postgresql/clause"
+	sql "github.com/AlephTav/sqb/sql/expression"
+	"testing"
+	"time"
+)
+
+func TestCreateTableStmt_EmptyCreateTable(t *testing.T) {
+	st := NewCreateTableStmt(nil)
+
+	sqb.CheckSql(t, "CREATE TABLE", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCreateTableStmt_RawColumns(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("tb").
+		IfNotExists().
+		Column("id", "bigint", "PRIMARY KEY").
+		Column("name", "text", "NOT NULL", "DEFAULT ''")
+
+	sqb.CheckSql(t, "CREATE TABLE IF NOT EXISTS tb (id bigint PRIMARY KEY, name text NOT NULL DEFAULT '')", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCreateTableStmt_ColumnDefinitions(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Temporary().
+		Table("users").
+		Column(postgresql.NewColumnDef("id", "bigint").GeneratedAsIdentity().PrimaryKey()).
+		Column(postgresql.NewColumnDef("email", "text").Collate(`"C"`).NotNull().Constraint("users_email_key").Unique()).
+		Column(postgresql.NewColumnDef("status", "text").Default("'new'").Check("status IN ('new', 'active')")).
+		Column(postgresql.NewColumnDef("company_id", "bigint").References("companies", "id").OnDelete("CASCADE")).
+		Column(postgresql.NewColumnDef("created_at", "timestamptz").Default(sql.NewExp("now()"))).
+		Column(postgresql.NewColumnDef("search", "tsvector").GeneratedAlwaysAs("to_tsvector('english', email)"))
+
+	sqb.CheckSql(
+		t,
+		"CREATE TEMPORARY TABLE users ("+
+			"id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY, "+
+			`email text COLLATE "C" NOT NULL CONSTRAINT users_email_key UNIQUE, `+
+			"status text DEFAULT 'new' CHECK (status IN ('new', 'active')), "+
+			"company_id bigint REFERENCES companies (id) ON DELETE CASCADE, "+
+			"created_at timestamptz DEFAULT now(), "+
+			"search tsvector GENERATED ALWAYS AS (to_tsvector('english', email)) STORED)",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestCreateTableStmt_TableConstraints(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("orders").
+		Column("id", "bigint").
+		Column("user_id", "bigint").
+		Column("total", "numeric").
+		Constraint(postgresql.PrimaryKey("id").Named("orders_pkey")).
+		Constraint(postgresql.ForeignKey("user_id").References("users", "id").OnDelete("RESTRICT").Deferrable(true)).
+		Constraint(postgresql.Unique("user_id", "id").Include("total")).
+		Constraint(postgresql.Check(sql.NewCondExp("total >= 0"))).
+		Constraint("EXCLUDE USING gist (id WITH =)")
+
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE orders (id bigint, user_id bigint, total numeric, "+
+			"CONSTRAINT orders_pkey PRIMARY KEY (id), "+
+			"FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE RESTRICT DEFERRABLE INITIALLY DEFERRED, "+
+			"UNIQUE (user_id, id) INCLUDE (total), "+
+			"CHECK (total >= 0), "+
+			"EXCLUDE USING gist (id WITH =))",
+		st.String(),
+	)
+}
+
+func TestCreateTableStmt_LiteralValues(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("tb").
+		Column(postgresql.NewColumnDef("qty", "int").Default(0).Check(sql.NewCondExp("qty >= 0"))).
+		Column(postgresql.NewColumnDef("rate", "float8").Default(0.5)).
+		Column(postgresql.NewColumnDef("active", "boolean").Default(true)).
+		Column(postgresql.NewColumnDef("note", "text").Default(nil))
+
+	sqb.CheckSql(
+		t,
+		"CREATE TABLE tb (qty int DEFAULT 0 CHECK (qty >= 0), rate float8 DEFAULT 0.5, "+
+			"active boolean DEFAULT TRUE, note text DEFAULT NULL)",
+		st.String(),
+	)
+	if err := st.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestCreateTableStmt_ExpressionWithParameters(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewCreateTableStmt(sqb.NewStatementExecutorMock()).
+		Table("tb").
+		Column("qty", "int").
+		Constraint(postgresql.Check(sql.NewCondExp("qty", ">=", 0)))
+
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about parameters of DDL expression")
+	}
+	if _, err := st.Exec(); err == nil {
+		t.Error("Exec() error is nil, expected the error about parameters of DDL expression")
+	}
+}
+
+func TestCreateTableStmt_TimeAndStringerValues(t *testing.T) {
+	st := NewCreateTableStmt(sqb.NewStatementExecutorMock()).
+		Table("tb").
+		Column(postgresql.NewColumnDef("created_at", "timestamp").Default(time.Date(2024, 1, 1, 3, 0, 0, 0, time.FixedZone("", 3*3600))))
+
+	sqb.CheckSql(t, "CREATE TABLE tb (created_at timestamp DEFAULT '2024-01-01 00:00:00')", st.String())
+	if err := st.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	st = NewCreateTableStmt(sqb.NewStatementExecutorMock()).
+		Table("tb").
+		Column(postgresql.NewColumnDef("timeout", "interval").Default(time.Minute))
+
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about the value of type time.Duration")
+	}
+}
+
+func TestCreateTableStmt_ReferentialActions(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("tb").
+		Column(postgresql.NewColumnDef("user_id", "bigint").References("users").NotNull().OnDelete("CASCADE").OnUpdate("NO ACTION"))
+
+	sqb.CheckSql(t, "CREATE TABLE tb (user_id bigint REFERENCES users ON DELETE CASCADE ON UPDATE NO ACTION NOT NULL)", st.String())
+
+	st = NewCreateTableStmt(nil).
+		Table("tb").
+		Column(postgresql.NewColumnDef("user_id", "bigint").NotNull().OnDelete("CASCADE"))
+
+	sqb.CheckSql(t, "CREATE TABLE tb (user_id bigint NOT NULL)", st.String())
+	if err := st.Validate(); err == nil {
+		t.Error("Validate() error is nil, expected the error about missing REFERENCES constraint")
+	}
+}
+
+func TestCreateTableStmt_PartitionBy(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Unlogged().
+		Table("events").
+		Column("id", "bigint").
+		Column("created_at", "timestamptz").
+		PartitionBy("RANGE", "created_at")
+
+	sqb.CheckSql(t, "CREATE UNLOGGED TABLE events (id bigint, created_at timestamptz) PARTITION BY RANGE (created_at)", st.String())
+}
+
+func TestCreateTableStmt_PartitionOf(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("events_2024").
+		PartitionOf("events", "FROM ('2024-01-01') TO ('2025-01-01')")
+
+	sqb.CheckSql(t, "CREATE TABLE events_2024 PARTITION OF events FOR VALUES FROM ('2024-01-01') TO ('2025-01-01')", st.String())
+}
+
+func TestCreateTableStmt_DefaultPartitionWithConstraint(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("events_default").
+		PartitionOf("events", "DEFAULT").
+		Constraint(postgresql.PrimaryKey("id"))
+
+	sqb.CheckSql(t, "CREATE TABLE events_default PARTITION OF events (PRIMARY KEY (id)) DEFAULT", st.String())
+}
+
+func TestCreateTableStmt_Exec(t *testing.T) {
+	count := NewCreateTableStmt(sqb.NewStatementExecutorMock()).
+		Table("tb").
+		Column("id", "int").
+		MustExec()
+
+	if count != 3 {
+		t.Errorf("Expected count is 3, actual is %d", count)
+	}
+}
+
+func TestCreateTableStmt_Copy(t *testing.T) {
+	origin := NewCreateTableStmt(nil).
+		Table("tb").
+		Column("id", "int").
+		PartitionBy("HASH", "id")
+	copied := origin.Copy().Column("name", "text")
+
+	sqb.CheckSql(t, "CREATE TABLE tb (id int) PARTITION BY HASH (id)", origin.String())
+	sqb.CheckSql(t, "CREATE TABLE tb (id int, name text) PARTITION BY HASH (id)", copied.String())
+}
+
+func TestCreateTableStmt_Clean(t *testing.T) {
+	st := NewCreateTableStmt(nil).
+		Table("tb").
+		IfNotExists().
+		Column("id", "int").
+		Clean()
+
+	sqb.CheckSql(t, "CREATE TABLE", st.String())
+}
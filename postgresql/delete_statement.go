@@ -17,6 +17,7 @@ type DeleteStmt struct {
 	*cls.UsingClause[*DeleteStmt]
 	*cls.WhereClause[*DeleteStmt]
 	*cls.ReturningClause[*DeleteStmt]
+	*cls.TableScopeClause[*DeleteStmt]
 }
 
 func NewDeleteStmt(db sqb.StatementExecutor) *DeleteStmt {
@@ -29,6 +30,7 @@ func NewDeleteStmt(db sqb.StatementExecutor) *DeleteStmt {
 	st.UsingClause = cls.NewUsingClause[*DeleteStmt](st)
 	st.WhereClause = cls.NewWhereClause[*DeleteStmt](st)
 	st.ReturningClause = cls.NewReturningClause[*DeleteStmt](st)
+	st.TableScopeClause = cls.NewTableScopeClause[*DeleteStmt](st)
 	return st
 }
 
@@ -40,6 +42,7 @@ func (s *DeleteStmt) Clean() *DeleteStmt {
 	s.CleanUsing()
 	s.CleanWhere()
 	s.CleanReturning()
+	s.CleanTableScope()
 	return s
 }
 
@@ -50,6 +53,7 @@ func (s *DeleteStmt) Copy() *DeleteStmt {
 	st.UsingClause = s.CopyUsing(st)
 	st.WhereClause = s.CopyWhere(st)
 	st.ReturningClause = s.CopyReturning(st)
+	st.TableScopeClause = s.CopyTableScope(st)
 	st.DataFetching = execution.NewDataFetching[*DeleteStmt](st)
 	st.StatementExecution = execution.NewStatementExecution[*DeleteStmt](st)
 	st.BaseStatement = sql.NewBaseStatement[*DeleteStmt](st, s.Executor())
@@ -63,8 +67,12 @@ func (s *DeleteStmt) Build() *DeleteStmt {
 	s.BaseStatement.Clean()
 	s.BuildWith()
 	s.BuildDelete()
-	s.BuildUsing()
-	s.BuildWhere()
+	if s.IsSoftDelete() {
+		s.BuildUsingAsFrom()
+	} else {
+		s.BuildUsing()
+	}
+	s.BuildWhere(s.ScopeConditions(s.DeleteTables(), s.UsingTables())...)
 	s.BuildReturning()
 	s.Built()
 	return s
@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"github.com/AlephTav/sqb"
+	cls "github.com/AlephTav/sqb/sql/clause"
 	sql "github.com/AlephTav/sqb/sql/expression"
 	"testing"
 )
@@ -371,3 +372,54 @@ func TestDeleteStmt_Clean(t *testing.T) {
 }
 
 //endregion
+
+//region WHEN AND SCOPE
+
+func TestDeleteStmt_Scope(t *testing.T) {
+	sqb.ResetParameterIndex()
+	tenant := func(s *DeleteStmt) *DeleteStmt { return s.Where("tenant_id", "=", 7) }
+	st := NewDeleteStmt(nil).
+		From("tb").
+		Scope(tenant).
+		When(true, func(s *DeleteStmt) *DeleteStmt { return s.Returning("id") })
+
+	sqb.CheckSql(t, "DELETE FROM tb WHERE tenant_id = :p1 RETURNING id", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": 7}, st.Params())
+}
+
+//endregion
+
+//region SOFT DELETE
+
+func TestDeleteStmt_SoftDelete(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewDeleteStmt(nil).
+		WithTableScopes(cls.NewTableScopes().Register("users", "soft_delete", cls.SoftDeleteScope("deleted_at"))).
+		From("users", "u").
+		Using("companies c").
+		Where("c.id = u.company_id").
+		AndWhere("c.name", "=", "x").
+		SoftDelete().
+		Returning("u.id")
+
+	sqb.CheckSql(
+		t,
+		"UPDATE users u SET deleted_at = now() FROM companies c "+
+			"WHERE (c.id = u.company_id AND c.name = :p1) AND u.deleted_at IS NULL RETURNING u.id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "x"}, st.Params())
+}
+
+func TestDeleteStmt_SoftDeleteWithValue(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewDeleteStmt(nil).
+		FromOnly("tb").
+		Where("id", "=", 1).
+		SoftDelete("removed_at", "2024-01-01")
+
+	sqb.CheckSql(t, "UPDATE ONLY tb SET removed_at = :p2 WHERE id = :p1", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": "2024-01-01"}, st.Params())
+}
+
+//endregion
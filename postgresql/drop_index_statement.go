@@ -0,0 +1,47 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/execution"
+	postgresql "github.com/AlephTav/sqb/postgresql/clause"
+	"github.com/AlephTav/sqb/sql"
+)
+
+type DropIndexStmt struct {
+	*execution.StatementExecution[*DropIndexStmt]
+	*sql.BaseStatement[*DropIndexStmt]
+	*postgresql.DropIndexClause[*DropIndexStmt]
+}
+
+func NewDropIndexStmt(db sqb.StatementExecutor) *DropIndexStmt {
+	st := &DropIndexStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*DropIndexStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*DropIndexStmt](st, db)
+	st.DropIndexClause = postgresql.NewDropIndexClause[*DropIndexStmt](st)
+	return st
+}
+
+func (s *DropIndexStmt) ItIsCommand() {}
+
+func (s *DropIndexStmt) Clean() *DropIndexStmt {
+	s.CleanDropIndex()
+	return s
+}
+
+func (s *DropIndexStmt) Copy() *DropIndexStmt {
+	st := &DropIndexStmt{}
+	st.DropIndexClause = s.CopyDropIndex(st)
+	st.StatementExecution = execution.NewStatementExecution[*DropIndexStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*DropIndexStmt](st, s.Executor())
+	return st
+}
+
+func (s *DropIndexStmt) Build() *DropIndexStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildDropIndex()
+	s.Built()
+	return s
+}
@@ -0,0 +1,32 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"testing"
+)
+
+func TestDropIndexStmt_EmptyDropIndex(t *testing.T) {
+	st := NewDropIndexStmt(nil)
+
+	sqb.CheckSql(t, "DROP INDEX", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestDropIndexStmt_Indexes(t *testing.T) {
+	st := NewDropIndexStmt(nil).
+		Concurrently().
+		IfExists().
+		Index("i1").
+		Index("i2").
+		Restrict()
+
+	sqb.CheckSql(t, "DROP INDEX CONCURRENTLY IF EXISTS i1, i2 RESTRICT", st.String())
+}
+
+func TestDropIndexStmt_Cascade(t *testing.T) {
+	st := NewDropIndexStmt(nil).
+		Index("i1").
+		Cascade()
+
+	sqb.CheckSql(t, "DROP INDEX i1 CASCADE", st.String())
+}
@@ -0,0 +1,47 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"github.com/AlephTav/sqb/execution"
+	postgresql "github.com/AlephTav/sqb/postgresql/clause"
+	"github.com/AlephTav/sqb/sql"
+)
+
+type DropTableStmt struct {
+	*execution.StatementExecution[*DropTableStmt]
+	*sql.BaseStatement[*DropTableStmt]
+	*postgresql.DropTableClause[*DropTableStmt]
+}
+
+func NewDropTableStmt(db sqb.StatementExecutor) *DropTableStmt {
+	st := &DropTableStmt{}
+	st.StatementExecution = execution.NewStatementExecution[*DropTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*DropTableStmt](st, db)
+	st.DropTableClause = postgresql.NewDropTableClause[*DropTableStmt](st)
+	return st
+}
+
+func (s *DropTableStmt) ItIsCommand() {}
+
+func (s *DropTableStmt) Clean() *DropTableStmt {
+	s.CleanDropTable()
+	return s
+}
+
+func (s *DropTableStmt) Copy() *DropTableStmt {
+	st := &DropTableStmt{}
+	st.DropTableClause = s.CopyDropTable(st)
+	st.StatementExecution = execution.NewStatementExecution[*DropTableStmt](st)
+	st.BaseStatement = sql.NewBaseStatement[*DropTableStmt](st, s.Executor())
+	return st
+}
+
+func (s *DropTableStmt) Build() *DropTableStmt {
+	if s.IsBuilt() {
+		return s
+	}
+	s.BaseStatement.Clean()
+	s.BuildDropTable()
+	s.Built()
+	return s
+}
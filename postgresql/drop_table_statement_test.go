@@ -0,0 +1,38 @@
+package postgresql
+
+import (
+	"github.com/AlephTav/sqb"
+	"testing"
+)
+
+func TestDropTableStmt_EmptyDropTable(t *testing.T) {
+	st := NewDropTableStmt(nil)
+
+	sqb.CheckSql(t, "DROP TABLE", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestDropTableStmt_Tables(t *testing.T) {
+	st := NewDropTableStmt(nil).
+		IfExists().
+		Table("t1").
+		Table([]any{"t2", "t3"}).
+		Cascade()
+
+	sqb.CheckSql(t, "DROP TABLE IF EXISTS t1, t2, t3 CASCADE", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestDropTableStmt_Restrict(t *testing.T) {
+	st := NewDropTableStmt(nil).
+		Table("tb").
+		Restrict()
+
+	sqb.CheckSql(t, "DROP TABLE tb RESTRICT", st.String())
+}
+
+func TestDropTableStmt_Clean(t *testing.T) {
+	st := NewDropTableStmt(nil).IfExists().Table("tb").Cascade().Clean()
+
+	sqb.CheckSql(t, "DROP TABLE", st.String())
+}
@@ -1,6 +1,8 @@
 package postgresql
 
 import (
+	"strings"
+
 	"github.com/AlephTav/sqb"
 	"github.com/AlephTav/sqb/execution"
 	postgresql "github.com/AlephTav/sqb/postgresql/clause"
@@ -17,6 +19,7 @@ type InsertStmt struct {
 	*postgresql.ValueListClause[*InsertStmt, *SelectStmt]
 	*postgresql.ConflictClause[*InsertStmt]
 	*cls.ReturningClause[*InsertStmt]
+	valuesErr error
 }
 
 func NewInsertStmt(db sqb.StatementExecutor) *InsertStmt {
@@ -41,6 +44,7 @@ func (s *InsertStmt) Clean() *InsertStmt {
 	s.CleanValueList()
 	s.CleanConflict()
 	s.CleanReturning()
+	s.valuesErr = nil
 	return s
 }
 
@@ -52,6 +56,7 @@ func (s *InsertStmt) Copy() *InsertStmt {
 	st.ValueListClause = s.CopyValueList(st)
 	st.ConflictClause = s.CopyConflict(st)
 	st.ReturningClause = s.CopyReturning(st)
+	st.valuesErr = s.valuesErr
 	st.DataFetching = execution.NewDataFetching[*InsertStmt](st)
 	st.BaseStatement = sql.NewBaseStatement[*InsertStmt](st, s.Executor())
 	return st
@@ -68,14 +73,48 @@ func (s *InsertStmt) Build() *InsertStmt {
 	s.BuildValueList()
 	s.BuildConflict()
 	s.BuildReturning()
+	if s.valuesErr != nil {
+		s.Fail(s.valuesErr)
+	}
 	s.Built()
 	return s
 }
 
 func (s *InsertStmt) MustExec(sequence string) any {
+	if err := s.Validate(); err != nil {
+		panic(err)
+	}
 	return s.Executor().MustInsert(s.String(), s.Params(), sequence)
 }
 
 func (s *InsertStmt) Exec(sequence string) (any, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
 	return s.Executor().Insert(s.String(), s.Params(), sequence)
 }
+
+// MaxParams is the maximum number of parameters of a statement supported by PostgreSQL.
+const MaxParams = 65535
+
+// Bulk splits the rows into statements having at most MaxParams parameters.
+// The limits can be changed with MaxParams and MaxRows of the returned bulk insert.
+func (s *InsertStmt) Bulk(rows []map[string]any) *execution.BulkInsert[*InsertStmt] {
+	return execution.NewBulkInsert[*InsertStmt](s, rows).MaxParams(MaxParams)
+}
+
+// ValuesUnnest inserts the rows passed as array parameters, one parameter per column, so the number of parameters
+// does not depend on the number of rows:
+//   - ValuesUnnest(types sqb.SliceMap, data any), see postgresql.NewUnnestArrays for the supported data
+//
+// E.g. ValuesUnnest(sqb.Map("id", "int", "name", "text"), rows) builds
+// INSERT INTO tb (id, name) SELECT * FROM unnest(:p1::int[], :p2::text[])
+// The statement fails if the data has columns missing from the types.
+func (s *InsertStmt) ValuesUnnest(types sqb.SliceMap, data any) *InsertStmt {
+	arrays := postgresql.NewUnnestArrays(types, data)
+	if s.valuesErr == nil {
+		s.valuesErr = arrays.Err()
+	}
+	s.Columns(strings.Join(arrays.Columns(), ", "))
+	return s.Select(NewSelectStmt(nil).From(arrays.Function()))
+}
@@ -1,8 +1,10 @@
 package postgresql
 
 import (
+	"errors"
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/expression"
+	"reflect"
 	"testing"
 )
 
@@ -540,3 +542,190 @@ func TestInsertStmt_Clean(t *testing.T) {
 }
 
 //endregion
+
+//region BULK INSERT
+
+type bulkExecutorMock struct {
+	sqb.StatementExecutorMock
+	statements    []string
+	inTransaction bool
+	fail          bool
+}
+
+func (m *bulkExecutorMock) Exec(sql string, params map[string]any) (int64, error) {
+	if m.fail {
+		return 0, errors.New("failed")
+	}
+	m.statements = append(m.statements, sql)
+	return int64(len(params) / 2), nil
+}
+
+func (m *bulkExecutorMock) Rows(sql string, params map[string]any) ([]map[string]any, error) {
+	m.statements = append(m.statements, sql)
+	rows := make([]map[string]any, 0, len(params)/2)
+	for i := 0; i < len(params)/2; i++ {
+		rows = append(rows, map[string]any{"id": len(m.statements)*10 + i})
+	}
+	return rows, nil
+}
+
+func (m *bulkExecutorMock) Transaction(callback func(db sqb.StatementExecutor) error) error {
+	m.inTransaction = true
+	return callback(m)
+}
+
+func bulkRows(count int) []map[string]any {
+	rows := make([]map[string]any, 0, count)
+	for i := 1; i <= count; i++ {
+		rows = append(rows, map[string]any{"id": i, "name": "n"})
+	}
+	return rows
+}
+
+func TestInsertStmt_BulkStatements(t *testing.T) {
+	sqb.ResetParameterIndex()
+	statements, err := NewInsertStmt(nil).
+		Into("tb").
+		OnConflict("id").
+		DoNothing().
+		Bulk(bulkRows(5)).
+		MaxParams(5).
+		Statements()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 3 {
+		t.Fatalf("Expected 3 statements, actual are %d", len(statements))
+	}
+	sqb.CheckSql(t, "INSERT INTO tb (id, name) VALUES (:p1, :p2), (:p3, :p4) ON CONFLICT (id) DO NOTHING", statements[0].String())
+	sqb.CheckSql(t, "INSERT INTO tb (id, name) VALUES (:p5, :p6), (:p7, :p8) ON CONFLICT (id) DO NOTHING", statements[1].String())
+	sqb.CheckSql(t, "INSERT INTO tb (id, name) VALUES (:p9, :p10) ON CONFLICT (id) DO NOTHING", statements[2].String())
+	sqb.CheckParams(t, map[string]any{"p9": 5, "p10": "n"}, statements[2].Params())
+}
+
+func TestInsertStmt_BulkDifferentColumns(t *testing.T) {
+	_, err := NewInsertStmt(nil).
+		Into("tb").
+		Bulk([]map[string]any{{"a": 1}, {"b": 2}}).
+		Statements()
+	if err == nil || err.Error() != `bulk insert row 2 has no column "a", expected columns are [a]` {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, err = NewInsertStmt(nil).
+		Into("tb").
+		Bulk([]map[string]any{{"a": 1}, {"a": 2, "b": 3}}).
+		Statements()
+	if err == nil || err.Error() != "bulk insert row 2 has 2 columns, expected columns are [a]" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestInsertStmt_BulkExec(t *testing.T) {
+	db := &bulkExecutorMock{}
+	result, err := NewInsertStmt(db).
+		Into("tb").
+		Bulk(bulkRows(5)).
+		MaxRows(2).
+		Exec()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Affected != 5 || result.Rows != nil || len(db.statements) != 3 || db.inTransaction {
+		t.Errorf("Unexpected result %#v of %d statements", result, len(db.statements))
+	}
+}
+
+func TestInsertStmt_BulkExecReturningInTransaction(t *testing.T) {
+	db := &bulkExecutorMock{}
+	result := NewInsertStmt(db).
+		Into("tb").
+		Returning("id").
+		Bulk(bulkRows(3)).
+		MaxRows(2).
+		InTransaction().
+		MustExec()
+
+	expected := []map[string]any{{"id": 10}, {"id": 11}, {"id": 20}}
+	if result.Affected != 3 || !reflect.DeepEqual(expected, result.Rows) || !db.inTransaction {
+		t.Errorf("Unexpected result %#v", result)
+	}
+}
+
+func TestInsertStmt_BulkErrors(t *testing.T) {
+	_, err := NewInsertStmt(&bulkExecutorMock{fail: true}).Into("tb").Bulk(bulkRows(3)).MaxRows(2).Exec()
+	if err == nil || err.Error() != "bulk insert chunk 1 of 2: failed" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, err = NewInsertStmt(sqb.NewStatementExecutorMock()).Into("tb").Bulk(bulkRows(3)).InTransaction().Exec()
+	if err == nil || err.Error() != "statement executor does not support transactions" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	_, err = NewInsertStmt(nil).Into("tb").Bulk(bulkRows(3)).MaxParams(1).Statements()
+	if err == nil || err.Error() != "a row of 2 columns exceeds the limit of 1 parameters" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+//endregion
+
+//region UNNEST
+
+func TestInsertStmt_ValuesUnnestRows(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewInsertStmt(nil).
+		Into("users").
+		ValuesUnnest(
+			sqb.Map("id", "int", "name", "text"),
+			[]sqb.SliceMap{sqb.Map("name", "a", "id", 1), sqb.Map("id", 2)},
+		).
+		OnConflict("id").
+		DoNothing()
+
+	sqb.CheckSql(
+		t,
+		"INSERT INTO users (id, name) SELECT * FROM unnest(:p1::int[], :p2::text[]) ON CONFLICT (id) DO NOTHING",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": []any{1, 2}, "p2": []any{"a", nil}}, st.Params())
+}
+
+func TestInsertStmt_ValuesUnnestColumns(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewInsertStmt(nil).
+		Into("users").
+		ValuesUnnest(
+			sqb.Map("id", "bigint", "tags", "text"),
+			sqb.Map("id", []int64{1, 2, 3}, "tags", []string{"a", "b", "c"}),
+		).
+		Returning("id")
+
+	sqb.CheckSql(t, "INSERT INTO users (id, tags) SELECT * FROM unnest(:p1::bigint[], :p2::text[]) RETURNING id", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": []int64{1, 2, 3}, "p2": []string{"a", "b", "c"}}, st.Params())
+}
+
+func TestInsertStmt_ValuesUnnestUnknownColumn(t *testing.T) {
+	st := NewInsertStmt(sqb.NewStatementExecutorMock()).
+		Into("users").
+		ValuesUnnest(sqb.Map("id", "int"), []map[string]any{{"id": 1, "email": "a@b.c"}})
+
+	expected := `unnest data has column "email" missing from the types of columns [id]`
+	if err := st.Validate(); err == nil || err.Error() != expected {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if _, err := st.Exec(""); err == nil || err.Error() != expected {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if err := st.Copy().Validate(); err == nil {
+		t.Error("Validate() of the copy error is nil")
+	}
+	if err := st.Clean().Into("users").Values(sqb.Map("id", 1)).Validate(); err != nil {
+		t.Errorf("Validate() of the cleaned statement error = %v", err)
+	}
+}
+
+//endregion
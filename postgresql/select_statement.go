@@ -13,8 +13,8 @@ type SelectStmt struct {
 	*sql.BaseStatement[*SelectStmt]
 	*postgresql.UnionClause[*SelectStmt]
 	*cls.WithClause[*SelectStmt]
-	*cls.FromClause[*SelectStmt]
-	*cls.SelectClause[*SelectStmt]
+	*postgresql.FromClause[*SelectStmt]
+	*postgresql.SelectClause[*SelectStmt]
 	*postgresql.JoinClause[*SelectStmt]
 	*cls.WhereClause[*SelectStmt]
 	*cls.GroupClause[*SelectStmt]
@@ -22,7 +22,9 @@ type SelectStmt struct {
 	*cls.OrderClause[*SelectStmt]
 	*cls.LimitClause[*SelectStmt]
 	*cls.OffsetClause[*SelectStmt]
+	*postgresql.FetchClause[*SelectStmt]
 	*postgresql.LockingClause[*SelectStmt]
+	*cls.TableScopeClause[*SelectStmt]
 }
 
 func NewSelectStmt(db sqb.StatementExecutor) *SelectStmt {
@@ -31,8 +33,8 @@ func NewSelectStmt(db sqb.StatementExecutor) *SelectStmt {
 	st.BaseStatement = sql.NewBaseStatement[*SelectStmt](st, db)
 	st.UnionClause = postgresql.NewUnionClause[*SelectStmt](st)
 	st.WithClause = cls.NewWithClause[*SelectStmt](st)
-	st.FromClause = cls.NewFromClause[*SelectStmt](st)
-	st.SelectClause = cls.NewSelectClause[*SelectStmt](st)
+	st.FromClause = postgresql.NewFromClause[*SelectStmt](st)
+	st.SelectClause = postgresql.NewSelectClause[*SelectStmt](st)
 	st.JoinClause = postgresql.NewJoinClause[*SelectStmt](st)
 	st.WhereClause = cls.NewWhereClause[*SelectStmt](st)
 	st.GroupClause = cls.NewGroupClause[*SelectStmt](st)
@@ -40,7 +42,9 @@ func NewSelectStmt(db sqb.StatementExecutor) *SelectStmt {
 	st.OrderClause = cls.NewOrderClause[*SelectStmt](st)
 	st.LimitClause = cls.NewLimitClause[*SelectStmt](st)
 	st.OffsetClause = cls.NewOffsetClause[*SelectStmt](st)
+	st.FetchClause = postgresql.NewFetchClause[*SelectStmt](st)
 	st.LockingClause = postgresql.NewLockingClause[*SelectStmt](st)
+	st.TableScopeClause = cls.NewTableScopeClause[*SelectStmt](st)
 	return st
 }
 
@@ -66,7 +70,7 @@ func (s *SelectStmt) Column(args ...string) ([]any, error) {
 	}
 	built := s.IsBuilt()
 	prevSelect := s.SelectClause
-	s.SelectClause = cls.NewSelectClause[*SelectStmt](s)
+	s.SelectClause = postgresql.NewSelectClause[*SelectStmt](s)
 	s.Select(args[0])
 	result, err := s.DataFetching.Column()
 	s.SelectClause = prevSelect
@@ -90,7 +94,7 @@ func (s *SelectStmt) One(args ...string) (any, error) {
 	}
 	built := s.IsBuilt()
 	prevSelect := s.SelectClause
-	s.SelectClause = cls.NewSelectClause[*SelectStmt](s)
+	s.SelectClause = postgresql.NewSelectClause[*SelectStmt](s)
 	s.Select(args[0])
 	result, err := s.DataFetching.One()
 	s.SelectClause = prevSelect
@@ -111,15 +115,18 @@ func (s *SelectStmt) MustCount(column string) int64 {
 func (s *SelectStmt) Count(column string) (int64, error) {
 	prevLimit := s.LimitClause
 	prevOffset := s.OffsetClause
+	prevFetch := s.FetchClause
 	prevOrder := s.OrderClause
 	prevGroup := s.GroupClause
 	s.LimitClause = cls.NewLimitClause[*SelectStmt](s)
 	s.OffsetClause = cls.NewOffsetClause[*SelectStmt](s)
+	s.FetchClause = postgresql.NewFetchClause[*SelectStmt](s)
 	s.OrderClause = cls.NewOrderClause[*SelectStmt](s)
 	s.GroupClause = cls.NewGroupClause[*SelectStmt](s)
 	result, err := s.CountWithNonConditionalClauses(column)
 	s.LimitClause = prevLimit
 	s.OffsetClause = prevOffset
+	s.FetchClause = prevFetch
 	s.OrderClause = prevOrder
 	s.GroupClause = prevGroup
 	return result, err
@@ -198,7 +205,9 @@ func (s *SelectStmt) Clean() *SelectStmt {
 	s.CleanOrder()
 	s.CleanLimit()
 	s.CleanOffset()
+	s.CleanFetch()
 	s.CleanLock()
+	s.CleanTableScope()
 	return s
 }
 
@@ -214,7 +223,9 @@ func (s *SelectStmt) Copy() *SelectStmt {
 	st.OrderClause = s.CopyOrder(st)
 	st.LimitClause = s.CopyLimit(st)
 	st.OffsetClause = s.CopyOffset(st)
+	st.FetchClause = s.CopyFetch(st)
 	st.LockingClause = s.CopyLock(st)
+	st.TableScopeClause = s.CopyTableScope(st)
 	st.DataFetching = execution.NewDataFetching[*SelectStmt](st)
 	st.BaseStatement = sql.NewBaseStatement[*SelectStmt](st, s.Executor())
 	st.UnionClause = postgresql.NewUnionClause[*SelectStmt](st)
@@ -231,18 +242,29 @@ func (s *SelectStmt) Build() *SelectStmt {
 		s.BuildOrder()
 		s.BuildLimit()
 		s.BuildOffset()
+		s.BuildFetch()
+		if err := s.ValidateFetch(s.HasLimit(), s.HasOrder()); err != nil {
+			s.Fail(err)
+		}
 	} else {
 		s.BuildWith()
 		s.BuildSelect()
 		s.BuildFrom()
-		s.BuildJoin()
-		s.BuildWhere()
+		s.BuildJoin(s.TableConditions)
+		s.BuildWhere(s.ScopeConditions(s.FromTables(), s.JoinWhereTables())...)
 		s.BuildGroup()
 		s.BuildHaving()
 		s.BuildOrder()
 		s.BuildLimit()
 		s.BuildOffset()
+		s.BuildFetch()
+		if err := s.ValidateFetch(s.HasLimit(), s.HasOrder()); err != nil {
+			s.Fail(err)
+		}
 		s.BuildLock()
+		if err := s.ValidateDistinctOn(s.OrderItems()); err != nil {
+			s.Fail(err)
+		}
 	}
 	s.Built()
 	return s
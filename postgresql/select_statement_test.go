@@ -2,8 +2,10 @@ package postgresql
 
 import (
 	"github.com/AlephTav/sqb"
+	cls "github.com/AlephTav/sqb/sql/clause"
 	sql "github.com/AlephTav/sqb/sql/expression"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -134,6 +136,37 @@ func TestSelectStmt_AppendMixedFrom(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_FromTableFunction(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From(sql.NewTableFunctionExp("generate_series", 1, 10).WithOrdinality().As("s", "n", "i")).
+		From(sql.NewTableFunctionExp("jsonb_to_recordset", `[{"a":1,"b":"x"}]`).As("x", "a int", "b text"))
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM generate_series(:p1, :p2) WITH ORDINALITY AS s(n, i), "+
+			"jsonb_to_recordset(:p3) AS x(a int, b text)",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": 10, "p3": `[{"a":1,"b":"x"}]`}, st.Params())
+}
+
+func TestSelectStmt_FromLateral(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From("users", "u").
+		FromLateral(NewSelectStmt(nil).From("orders", "o").Where("o.user_id = u.id").Where("o.total", ">", 100), "o").
+		FromLateral(sql.NewTableFunctionExp("jsonb_array_elements", sql.NewExp("u.tags")).As("tag"))
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM users u, LATERAL (SELECT * FROM orders o WHERE o.user_id = u.id AND o.total > :p1) o, "+
+			"LATERAL jsonb_array_elements(u.tags) AS tag",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 100}, st.Params())
+}
+
 //endregion
 
 //region SELECT
@@ -235,6 +268,118 @@ func TestSelectStmt_SelectFromMixedSources(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": 2, "p3": 3}, st.Params())
 }
 
+func TestSelectStmt_DistinctOn(t *testing.T) {
+	st := NewSelectStmt(sqb.NewStatementExecutorMock()).
+		DistinctOn("user_id", "date_trunc('day', created_at)").
+		Select("user_id, amount").
+		From("payments").
+		OrderBy("user_id").
+		OrderBy("date_trunc('day', created_at)", "DESC NULLS LAST").
+		OrderBy("created_at", "DESC")
+
+	sqb.CheckSql(
+		t,
+		"SELECT DISTINCT ON (user_id, date_trunc('day', created_at)) user_id, amount FROM payments "+
+			"ORDER BY user_id, date_trunc('day', created_at) DESC NULLS LAST, created_at DESC",
+		st.String(),
+	)
+	if err := st.Validate(); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestSelectStmt_DistinctOnAllColumns(t *testing.T) {
+	st := NewSelectStmt(nil).
+		DistinctOn("a").
+		From("tb")
+
+	sqb.CheckSql(t, "SELECT DISTINCT ON (a) * FROM tb", st.String())
+	if err := st.Validate(); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestSelectStmt_DistinctOnWithMismatchedOrder(t *testing.T) {
+	st := NewSelectStmt(sqb.NewStatementExecutorMock()).
+		DistinctOn("a", "b").
+		From("tb").
+		OrderBy("a").
+		OrderBy("c", "DESC")
+
+	sqb.CheckSql(t, "SELECT DISTINCT ON (a, b) * FROM tb ORDER BY a, c DESC", st.String())
+
+	_, err := st.Rows()
+
+	expected := `SELECT DISTINCT ON expressions must match initial ORDER BY expressions, "c" is not in DISTINCT ON (a, b)`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if err := st.Build().Validate(); err == nil || err.Error() != expected {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if err := st.Copy().CleanOrder().OrderBy("b").OrderBy("a").Validate(); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestSelectStmt_CopyKeepsDistinct(t *testing.T) {
+	distinct := NewSelectStmt(nil).Distinct().Select("a").From("tb").Copy()
+	distinctOn := NewSelectStmt(nil).DistinctOn("a").Select("a, b").From("tb").Copy()
+
+	sqb.CheckSql(t, "SELECT DISTINCT a FROM tb", distinct.String())
+	sqb.CheckSql(t, "SELECT DISTINCT ON (a) a, b FROM tb", distinctOn.String())
+	sqb.CheckSql(t, "SELECT a, b FROM tb", distinctOn.Copy().CleanSelect().Select("a, b").String())
+}
+
+func TestSelectStmt_SelectAggregateWithFilter(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		Select(sql.Count("*"), "total").
+		Select(sql.Count("*").Filter("status", "=", "active"), "active").
+		Select(sql.Sum("amount").Filter("paid").OrFilter("refunded", "=", false), "amount").
+		From("orders")
+
+	sqb.CheckSql(
+		t,
+		"SELECT count(*) total, count(*) FILTER (WHERE status = :p1) active, "+
+			"sum(amount) FILTER (WHERE paid OR refunded = :p2) amount FROM orders",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "active", "p2": false}, st.Params())
+}
+
+func TestSelectStmt_SelectAggregateWithDistinctAndOrder(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select(sql.StringAgg("name", "','").OrderBy("name").OrderBy("id", "DESC"), "names").
+		Select(sql.ArrayAgg("tag").Distinct(), "tags").
+		From("users")
+
+	sqb.CheckSql(
+		t,
+		"SELECT string_agg(name, ',' ORDER BY name, id DESC) names, array_agg(DISTINCT tag) tags FROM users",
+		st.String(),
+	)
+}
+
+func TestSelectStmt_SelectOrderedSetAggregate(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		Select(sql.NewAggregateExp("percentile_cont", sql.NewExp("0.5")).WithinGroup("salary"), "median").
+		Select(
+			sql.NewAggregateExp("mode").WithinGroup("dept", "DESC").Filter("salary", ">", 100),
+			"top_dept",
+		).
+		From("employees")
+
+	sqb.CheckSql(
+		t,
+		"SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY salary) median, "+
+			"mode() WITHIN GROUP (ORDER BY dept DESC) FILTER (WHERE salary > :p1) top_dept FROM employees",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 100}, st.Params())
+}
+
 //endregion
 
 //region JOIN
@@ -334,6 +479,32 @@ func TestSelectStmt_JoinTableWithNestedConditions(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_JoinLateral(t *testing.T) {
+	sqb.ResetParameterIndex()
+	latest := NewSelectStmt(nil).
+		From("orders").
+		Where("user_id = u.id").
+		OrderBy("created_at", "DESC").
+		Limit(1)
+	st := NewSelectStmt(nil).
+		From("users", "u").
+		LeftJoinLateral(latest, "o", "true").
+		InnerJoinLateral(sql.NewTableFunctionExp("unnest", sql.NewExp("u.roles")).As("r", "role"), "r.role <> 'guest'").
+		CrossJoinLateral(sql.NewTableFunctionExp("generate_series", 1, sql.NewExp("u.level")), "s").
+		CrossJoinLateral(sql.NewTableFunctionExp("jsonb_to_record", sql.NewExp("u.meta")).As("m", "a int"))
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM users u "+
+			"LEFT JOIN LATERAL (SELECT * FROM orders WHERE user_id = u.id ORDER BY created_at DESC LIMIT 1) o ON true "+
+			"INNER JOIN LATERAL unnest(u.roles) AS r(role) ON r.role <> 'guest' "+
+			"CROSS JOIN LATERAL generate_series(:p1, u.level) s "+
+			"CROSS JOIN LATERAL jsonb_to_record(u.meta) AS m(a int)",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 1}, st.Params())
+}
+
 func TestSelectStmt_JoinOfDifferentTypes(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("t1").
@@ -682,6 +853,24 @@ func TestSelectStmt_HavingWithQueriesAsOperandAndValue(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_HavingWithAggregate(t *testing.T) {
+	sqb.ResetParameterIndex()
+	count := sql.Count("*").Filter("status", "=", "failed")
+	st := NewSelectStmt(nil).
+		Select("user_id").
+		From("jobs").
+		GroupBy("user_id").
+		Having(count, ">", 5)
+
+	sqb.CheckSql(
+		t,
+		"SELECT user_id FROM jobs GROUP BY user_id HAVING count(*) FILTER (WHERE status = :p1) > :p2",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "failed", "p2": 5}, st.Params())
+	sqb.CheckSql(t, count.String(), count.Copy().String())
+}
+
 func TestSelectStmt_HavingAsConditionList(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("tb").
@@ -766,6 +955,59 @@ func TestSelectStmt_GroupByAppendColumns(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_GroupByRollupAndCube(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select("a, b, c, SUM(x)").
+		Select(sql.Grouping("a", "b"), "g").
+		From("tb").
+		GroupBy("a").
+		Rollup("b", sql.NewExp("date_trunc('month', d)")).
+		Cube("c", "e")
+
+	sqb.CheckSql(
+		t,
+		"SELECT a, b, c, SUM(x), GROUPING(a, b) g FROM tb GROUP BY a, ROLLUP(b, date_trunc('month', d)), CUBE(c, e)",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_GroupByEmptyRollupAndCube(t *testing.T) {
+	st := NewSelectStmt(sqb.NewStatementExecutorMock()).From("tb").GroupBy("a").Rollup()
+
+	if err := st.Validate(); err == nil || err.Error() != "ROLLUP requires at least one column" {
+		t.Errorf("Validate() error = %v, expected the error about ROLLUP without columns", err)
+	}
+	if _, err := st.Rows(); err == nil {
+		t.Error("Rows() error is nil, expected the error about ROLLUP without columns")
+	}
+	if err := st.Copy().Validate(); err == nil {
+		t.Error("Validate() error of the copy is nil, expected the error about ROLLUP without columns")
+	}
+	if err := st.CleanGroup().Cube().Validate(); err == nil || err.Error() != "CUBE requires at least one column" {
+		t.Errorf("Validate() error = %v, expected the error about CUBE without columns", err)
+	}
+	if err := st.CleanGroup().Rollup("a").Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestSelectStmt_GroupByGroupingSets(t *testing.T) {
+	st := NewSelectStmt(nil).
+		Select("brand, size, SUM(sales)").
+		From("items_sold").
+		GroupingSets([]any{"brand"}, []any{"brand", "size"}, "size", nil, []any{})
+
+	sqb.CheckSql(
+		t,
+		"SELECT brand, size, SUM(sales) FROM items_sold GROUP BY GROUPING SETS ((brand), (brand, size), (size), (), ())",
+		st.String(),
+	)
+
+	copied := st.Copy()
+	sqb.CheckSql(t, st.String(), copied.String())
+}
+
 func TestSelectStmt_GroupByQuery(t *testing.T) {
 	st := NewSelectStmt(nil).
 		From("t1").
@@ -901,6 +1143,51 @@ func TestSelectStmt_LimitAndOffset(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{}, st.Params())
 }
 
+func TestSelectStmt_FetchFirst(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("tb").
+		OrderBy("a").
+		Offset(10).
+		FetchFirst(5)
+
+	sqb.CheckSql(t, "SELECT * FROM tb ORDER BY a OFFSET 10 FETCH FIRST 5 ROWS ONLY", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_FetchFirstWithTies(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("tb").
+		OrderBy("a").
+		FetchFirst(3).
+		WithTies()
+
+	sqb.CheckSql(t, "SELECT * FROM tb ORDER BY a FETCH FIRST 3 ROWS WITH TIES", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_InvalidFetch(t *testing.T) {
+	statements := []*SelectStmt{
+		NewSelectStmt(nil).From("tb").OrderBy("a").Limit(5).FetchFirst(3),
+		NewSelectStmt(nil).From("tb").FetchFirst(3).WithTies(),
+	}
+	for _, st := range statements {
+		if err := st.Validate(); err == nil {
+			t.Errorf("Validate() error is nil for %q, expected the error about FETCH FIRST", st.String())
+		}
+	}
+}
+
+func TestSelectStmt_CleanFetch(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("tb").
+		FetchFirst(3).
+		WithTies().
+		CleanFetch()
+
+	sqb.CheckSql(t, "SELECT * FROM tb", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
 //endregion
 
 //region LOCK
@@ -1084,6 +1371,71 @@ func TestSelectStmt_WithRecursiveQuery(t *testing.T) {
 	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": 100}, st.Params())
 }
 
+func TestSelectStmt_WithListOfQueries(t *testing.T) {
+	st := NewSelectStmt(nil).
+		With([]any{[]any{"t1", "(SELECT 1)"}, []any{"t2", "(SELECT 2)"}}).
+		With(sqb.Map("t3", "(SELECT 3)", "t4", "(SELECT 4)")).
+		From("t1")
+
+	sqb.CheckSql(
+		t,
+		"WITH t1 AS (SELECT 1), t2 AS (SELECT 2), t3 AS (SELECT 3), t4 AS (SELECT 4) SELECT * FROM t1",
+		st.String(),
+	)
+}
+
+func TestSelectStmt_WithMaterializedCte(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		With(sql.NewCteExp("active", NewSelectStmt(nil).From("users").Where("status", "=", "active")).
+			Columns("id", "name").
+			Materialized()).
+		With(sql.NewCteExp("recent", "(SELECT * FROM orders)").NotMaterialized()).
+		From("active").
+		From("recent")
+
+	sqb.CheckSql(
+		t,
+		"WITH active(id, name) AS MATERIALIZED (SELECT * FROM users WHERE status = :p1), "+
+			"recent AS NOT MATERIALIZED (SELECT * FROM orders) SELECT * FROM active, recent",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "active"}, st.Params())
+}
+
+func TestSelectStmt_WithRecursiveSearchAndCycle(t *testing.T) {
+	tree := NewSelectStmt(nil).
+		From("nodes").
+		Select("id, parent_id").
+		Where("parent_id IS NULL").
+		UnionAll(
+			NewSelectStmt(nil).
+				From("nodes", "n").
+				Select("n.id, n.parent_id").
+				InnerJoin("tree", "t", "n.parent_id = t.id"),
+		)
+	st := NewSelectStmt(nil).
+		WithRecursive(sql.NewCteExp("tree", tree).
+			Columns("id", "parent_id").
+			SearchDepthFirst("ordercol", "id").
+			Cycle("is_cycle", "path", "id")).
+		From("tree").
+		OrderBy("ordercol")
+
+	sqb.CheckSql(
+		t,
+		"WITH RECURSIVE tree(id, parent_id) AS ((SELECT id, parent_id FROM nodes WHERE parent_id IS NULL) "+
+			"UNION ALL (SELECT n.id, n.parent_id FROM nodes n INNER JOIN tree t ON n.parent_id = t.id)) "+
+			"SEARCH DEPTH FIRST BY id SET ordercol CYCLE id SET is_cycle USING path "+
+			"SELECT * FROM tree ORDER BY ordercol",
+		st.String(),
+	)
+
+	breadth := sql.NewCteExp("tree", "(SELECT 1)").SearchBreadthFirst("ord", "a", "b")
+	sqb.CheckSql(t, "tree AS (SELECT 1) SEARCH BREADTH FIRST BY a, b SET ord", breadth.String())
+	sqb.CheckSql(t, breadth.String(), breadth.Copy().String())
+}
+
 //endregion
 
 //region Copy & Clean
@@ -1403,3 +1755,247 @@ func TestSelectStmt_Batches(t *testing.T) {
 }
 
 //endregion
+
+//region WHEN AND SCOPE
+
+func TestSelectStmt_WhenTrue(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		From("users u").
+		When(true, func(s *SelectStmt) *SelectStmt {
+			return s.InnerJoin("companies c", "c.id = u.company_id").Where("c.name", "=", "x")
+		}).
+		OrderBy("u.id")
+
+	sqb.CheckSql(t, "SELECT * FROM users u INNER JOIN companies c ON c.id = u.company_id WHERE c.name = :p1 ORDER BY u.id", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": "x"}, st.Params())
+}
+
+func TestSelectStmt_WhenFalseWithFallback(t *testing.T) {
+	st := NewSelectStmt(nil).
+		From("users").
+		When(
+			false,
+			func(s *SelectStmt) *SelectStmt { return s.OrderBy("name") },
+			func(s *SelectStmt) *SelectStmt { return s.OrderBy("id", "DESC") },
+		)
+
+	sqb.CheckSql(t, "SELECT * FROM users ORDER BY id DESC", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_Scope(t *testing.T) {
+	active := func(s *SelectStmt) *SelectStmt { return s.Where("deleted_at IS NULL") }
+	latest := func(s *SelectStmt) *SelectStmt { return s.OrderBy("created_at", "DESC") }
+	st := NewSelectStmt(nil).
+		From("users").
+		Scope(active, latest).
+		Limit(10)
+
+	sqb.CheckSql(t, "SELECT * FROM users WHERE deleted_at IS NULL ORDER BY created_at DESC LIMIT 10", st.String())
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+//endregion
+
+//region TABLE SCOPES
+
+func tenantScopes() *cls.TableScopes {
+	return cls.NewTableScopes().
+		Register("users", "tenant", func(table string) []any { return []any{table + ".tenant_id", "=", 7} }).
+		Register("users", "soft_delete", cls.SoftDeleteScope("deleted_at")).
+		Register("companies", "soft_delete", cls.SoftDeleteScope("deleted_at"))
+}
+
+func TestSelectStmt_TableScopes(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		WithTableScopes(tenantScopes()).
+		From("users u").
+		LeftJoin("companies c", "c.id = u.company_id").
+		Where("u.name", "=", "x").
+		OrWhere("u.name", "=", "y")
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM users u LEFT JOIN companies c ON (c.id = u.company_id) AND c.deleted_at IS NULL "+
+			"WHERE (u.name = :p1 OR u.name = :p2) AND u.tenant_id = :p3 AND u.deleted_at IS NULL",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "x", "p2": "y", "p3": 7}, st.Params())
+}
+
+func TestSelectStmt_TableScopesOfSubqueryAndCrossJoin(t *testing.T) {
+	scopes := tenantScopes()
+	st := NewSelectStmt(nil).
+		WithTableScopes(scopes).
+		From(NewSelectStmt(nil).WithTableScopes(scopes).From("companies"), "c").
+		CrossJoin(sqb.Map("u", "public.users")).
+		Unscoped("tenant")
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM (SELECT * FROM companies WHERE companies.deleted_at IS NULL) c CROSS JOIN public.users u WHERE u.deleted_at IS NULL",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{}, st.Params())
+}
+
+func TestSelectStmt_TableScopesOfLeftJoinUsing(t *testing.T) {
+	st := NewSelectStmt(nil).
+		WithTableScopes(tenantScopes()).
+		From("orders o").
+		LeftJoin("companies c", []any{"company_id"})
+
+	sqb.CheckSql(t, "SELECT * FROM orders o LEFT JOIN companies c USING (company_id)", st.String())
+	if err := st.Validate(); err == nil || !strings.Contains(err.Error(), "companies") {
+		t.Errorf("Validate() error = %v, expected the error about scopes of table companies", err)
+	}
+	if _, err := st.Rows(); err == nil {
+		t.Error("Rows() error is nil, expected the scope error")
+	}
+	if err := st.Unscoped().Validate(); err != nil {
+		t.Errorf("Validate() of unscoped statement error = %v", err)
+	}
+}
+
+func TestSelectStmt_TableScopesOfInvalidSubquery(t *testing.T) {
+	subquery := func() *SelectStmt {
+		return NewSelectStmt(nil).
+			WithTableScopes(tenantScopes()).
+			Select("o.id").
+			From("orders o").
+			LeftJoin("companies c", []any{"company_id"})
+	}
+	statements := []*SelectStmt{
+		NewSelectStmt(sqb.NewStatementExecutorMock()).From(subquery(), "x"),
+		NewSelectStmt(sqb.NewStatementExecutorMock()).From("orders").Where("id", "IN", subquery()),
+	}
+	for _, st := range statements {
+		if err := st.Validate(); err == nil || !strings.Contains(err.Error(), "companies") {
+			t.Errorf("Validate() error = %v, expected the error about scopes of table companies", err)
+		}
+		if _, err := st.Rows(); err == nil {
+			t.Error("Rows() error is nil, expected the scope error of the subquery")
+		}
+		if _, ok := st.Params()["\x00error"]; ok {
+			t.Error("Params() has the error of the subquery")
+		}
+	}
+}
+
+func TestSelectStmt_TableScopesOfInnerJoinUsing(t *testing.T) {
+	st := NewSelectStmt(nil).
+		WithTableScopes(tenantScopes()).
+		From("orders o").
+		InnerJoin("companies c", []any{"company_id"})
+
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM orders o INNER JOIN companies c USING (company_id) WHERE c.deleted_at IS NULL",
+		st.String(),
+	)
+	if err := st.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestSelectStmt_TableScopesOfQuotedTable(t *testing.T) {
+	st := NewSelectStmt(nil).
+		WithTableScopes(tenantScopes()).
+		Unscoped("tenant").
+		From(`"public"."users" u`)
+
+	sqb.CheckSql(t, `SELECT * FROM "public"."users" u WHERE u.deleted_at IS NULL`, st.String())
+	if err := st.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestSelectStmt_TableScopesOfUnresolvedTable(t *testing.T) {
+	st := NewSelectStmt(nil).
+		WithTableScopes(tenantScopes()).
+		From("LATERAL (SELECT * FROM users) u")
+
+	if err := st.Validate(); err == nil || !strings.Contains(err.Error(), "users") {
+		t.Errorf("Validate() error = %v, expected the error about scopes of table users", err)
+	}
+	if err := st.Copy().CleanFrom().From("generate_series(1, 10) s").Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestSelectStmt_TableScopesOfTableMap(t *testing.T) {
+	st := NewSelectStmt(nil).
+		WithTableScopes(tenantScopes()).
+		Unscoped("tenant").
+		From(map[string]any{"u": "users", "c": "companies"})
+
+	for i := 0; i < 10; i++ {
+		sqb.CheckSql(
+			t,
+			[]string{
+				"SELECT * FROM companies c, users u WHERE c.deleted_at IS NULL AND u.deleted_at IS NULL",
+				"SELECT * FROM users u, companies c WHERE c.deleted_at IS NULL AND u.deleted_at IS NULL",
+			},
+			st.Dirty().String(),
+		)
+	}
+}
+
+func TestSelectStmt_TableScopesOfLateralSubquery(t *testing.T) {
+	scopes := tenantScopes()
+	st := NewSelectStmt(nil).
+		WithTableScopes(scopes).
+		Unscoped("tenant").
+		From("companies c").
+		FromLateral(
+			NewSelectStmt(nil).
+				WithTableScopes(scopes).
+				Unscoped("tenant").
+				From("users").
+				Where("users.company_id = c.id"),
+			"u",
+		)
+
+	if err := st.Validate(); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+	sqb.CheckSql(
+		t,
+		"SELECT * FROM companies c, LATERAL (SELECT * FROM users WHERE (users.company_id = c.id) "+
+			"AND users.deleted_at IS NULL) u WHERE c.deleted_at IS NULL",
+		st.String(),
+	)
+}
+
+func TestSelectStmt_Unscoped(t *testing.T) {
+	cls.RegisterTableScope("users", "soft_delete", cls.SoftDeleteScope("deleted_at"))
+	defer cls.DefaultTableScopes.Remove("users", "soft_delete")
+	scoped := NewSelectStmt(nil).From("users")
+	unscoped := scoped.Copy().Unscoped()
+
+	sqb.CheckSql(t, "SELECT * FROM users WHERE users.deleted_at IS NULL", scoped.String())
+	sqb.CheckSql(t, "SELECT * FROM users", unscoped.String())
+}
+
+//endregion
+
+//region TABLE ALIAS WITH COLUMNS
+
+func TestSelectStmt_FromDerivedTableWithColumnAliases(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewSelectStmt(nil).
+		Select("v.id, v.name").
+		From(NewValuesStmt(nil).Values([]any{[]any{1, "a"}, []any{2, "b"}}), sql.NewTableAliasExp("v", "id", "name")).
+		InnerJoin("users u", "u.id = v.id")
+
+	sqb.CheckSql(
+		t,
+		"SELECT v.id, v.name FROM (VALUES (:p1, :p2), (:p3, :p4)) AS v(id, name) INNER JOIN users u ON u.id = v.id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": "a", "p3": 2, "p4": "b"}, st.Params())
+}
+
+//endregion
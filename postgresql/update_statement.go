@@ -1,11 +1,16 @@
 package postgresql
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+
 	"github.com/AlephTav/sqb"
 	"github.com/AlephTav/sqb/execution"
 	postgresql "github.com/AlephTav/sqb/postgresql/clause"
 	"github.com/AlephTav/sqb/sql"
 	cls "github.com/AlephTav/sqb/sql/clause"
+	exp "github.com/AlephTav/sqb/sql/expression"
 )
 
 type UpdateStmt struct {
@@ -15,9 +20,11 @@ type UpdateStmt struct {
 	*cls.WithClause[*UpdateStmt]
 	*postgresql.UpdateClause[*UpdateStmt]
 	*cls.AssignmentClause[*UpdateStmt]
-	*cls.FromClause[*UpdateStmt]
+	*postgresql.FromClause[*UpdateStmt]
 	*cls.WhereClause[*UpdateStmt]
 	*cls.ReturningClause[*UpdateStmt]
+	*cls.TableScopeClause[*UpdateStmt]
+	valuesErr error
 }
 
 func NewUpdateStmt(db sqb.StatementExecutor) *UpdateStmt {
@@ -28,9 +35,10 @@ func NewUpdateStmt(db sqb.StatementExecutor) *UpdateStmt {
 	st.WithClause = cls.NewWithClause[*UpdateStmt](st)
 	st.UpdateClause = postgresql.NewUpdateClause[*UpdateStmt](st)
 	st.AssignmentClause = cls.NewAssignmentClause[*UpdateStmt](st)
-	st.FromClause = cls.NewFromClause[*UpdateStmt](st)
+	st.FromClause = postgresql.NewFromClause[*UpdateStmt](st)
 	st.WhereClause = cls.NewWhereClause[*UpdateStmt](st)
 	st.ReturningClause = cls.NewReturningClause[*UpdateStmt](st)
+	st.TableScopeClause = cls.NewTableScopeClause[*UpdateStmt](st)
 	return st
 }
 
@@ -43,6 +51,8 @@ func (s *UpdateStmt) Clean() *UpdateStmt {
 	s.CleanFrom()
 	s.CleanWhere()
 	s.CleanReturning()
+	s.CleanTableScope()
+	s.valuesErr = nil
 	return s
 }
 
@@ -54,6 +64,8 @@ func (s *UpdateStmt) Copy() *UpdateStmt {
 	st.FromClause = s.CopyFrom(st)
 	st.WhereClause = s.CopyWhere(st)
 	st.ReturningClause = s.CopyReturning(st)
+	st.TableScopeClause = s.CopyTableScope(st)
+	st.valuesErr = s.valuesErr
 	st.DataFetching = execution.NewDataFetching[*UpdateStmt](st)
 	st.StatementExecution = execution.NewStatementExecution[*UpdateStmt](st)
 	st.BaseStatement = sql.NewBaseStatement[*UpdateStmt](st, s.Executor())
@@ -69,8 +81,121 @@ func (s *UpdateStmt) Build() *UpdateStmt {
 	s.BuildUpdate()
 	s.BuildAssignment()
 	s.BuildFrom()
-	s.BuildWhere()
+	s.BuildWhere(s.ScopeConditions(s.UpdateTables(), s.FromTables())...)
 	s.BuildReturning()
+	if s.valuesErr != nil {
+		s.Fail(s.valuesErr)
+	}
 	s.Built()
 	return s
 }
+
+// UnnestAlias is the alias of the derived table of the rows updated by ValuesUnnest and BulkUpdate.
+const UnnestAlias = "v"
+
+// ValuesUnnest updates the table rows matching the rows passed as array parameters by the key columns,
+// the other columns are assigned from the passed rows. The table must be set before:
+//   - ValuesUnnest(types sqb.SliceMap, data any), the key is the first column of the types
+//   - ValuesUnnest(types sqb.SliceMap, data any, keys ...string)
+//
+// E.g. ValuesUnnest(sqb.Map("id", "int", "name", "text"), rows) builds
+// UPDATE tb SET name = v.name FROM (SELECT unnest(:p1::int[]) AS id, unnest(:p2::text[]) AS name) v WHERE tb.id = v.id
+// The statement fails if the data or the keys have columns missing from the types or a row misses any column
+// of the types, since the missing value would set the column to NULL.
+func (s *UpdateStmt) ValuesUnnest(types sqb.SliceMap, data any, keys ...string) *UpdateStmt {
+	arrays := postgresql.NewStrictUnnestArrays(types, data)
+	s.fail(arrays.Err())
+	rows := arrays.Select()
+	return s.updateFrom(exp.NewExpWithParams("("+rows.String()+")", rows.Params()), UnnestAlias, arrays.Columns(), keys)
+}
+
+// BulkUpdate updates the table rows matching the given rows by the key columns,
+// the other columns are assigned from the given rows. The table must be set before:
+//   - BulkUpdate(types sqb.SliceMap, rows any), the key is the first column of the types
+//   - BulkUpdate(types sqb.SliceMap, rows any, keys ...string)
+//
+// The rows are maps, slice maps or structs, see sqb.ToSliceMaps. The types define the columns and cast the values
+// of the first row so that PostgreSQL does not resolve the columns as text. If the types are nil,
+// the columns are taken from the first row, the values are not cast and the keys must be given
+// since the order of the columns of maps is not defined. The statement fails if there are no rows
+// or a row has other columns, so a missing value does not set the column to NULL.
+// E.g. BulkUpdate(sqb.Map("id", "int", "a", "text"), rows) builds
+// UPDATE tb SET a = v.a FROM (VALUES (:p1::int, :p2::text), (:p3, :p4)) AS v(id, a) WHERE tb.id = v.id
+func (s *UpdateStmt) BulkUpdate(types sqb.SliceMap, rows any, keys ...string) *UpdateStmt {
+	items := sqb.ToSliceMaps(rows)
+	if len(items) == 0 {
+		s.fail(errors.New("bulk update requires at least one row"))
+	}
+	if types == nil && len(keys) == 0 {
+		s.fail(errors.New("bulk update without types requires the key columns"))
+	}
+	var columns []string
+	if types != nil {
+		for i := 0; i < len(types); i += 2 {
+			columns = append(columns, fmt.Sprint(types[i]))
+		}
+	} else if len(items) > 0 {
+		for _, column := range sqb.Keys(items[0]) {
+			columns = append(columns, fmt.Sprint(column))
+		}
+	}
+	values := make([]any, 0, len(items))
+	for i, item := range items {
+		row := make(map[string]any, len(item)/2)
+		for j := 0; j+1 < len(item); j += 2 {
+			row[fmt.Sprint(item[j])] = item[j+1]
+		}
+		if len(row) != len(columns) {
+			s.fail(fmt.Errorf("bulk update row %d has %d columns, expected columns are %v", i+1, len(row), columns))
+		}
+		value := make([]any, 0, len(columns))
+		for j, column := range columns {
+			if i == 0 && types != nil {
+				name := sqb.NextParameterName()
+				value = append(value, exp.NewExpWithParams(
+					":"+name+"::"+fmt.Sprint(types[2*j+1]),
+					map[string]any{name: row[column]},
+				))
+			} else {
+				value = append(value, row[column])
+			}
+			if _, exists := row[column]; !exists {
+				s.fail(fmt.Errorf("bulk update row %d has no column %q, expected columns are %v", i+1, column, columns))
+			}
+		}
+		values = append(values, value)
+	}
+	return s.updateFrom(NewValuesStmt(nil).Values(values), exp.NewTableAliasExp(UnnestAlias, columns...), columns, keys)
+}
+
+func (s *UpdateStmt) updateFrom(source any, alias any, columns []string, keys []string) *UpdateStmt {
+	if len(keys) == 0 && len(columns) > 0 {
+		keys = columns[:1]
+	}
+	for _, key := range keys {
+		if !slices.Contains(columns, key) {
+			s.fail(fmt.Errorf("key %q is not one of the updated columns %v", key, columns))
+		}
+	}
+	table := ""
+	if tables := s.UpdateTables(); len(tables) > 0 {
+		table = tables[0].Ref() + "."
+	}
+	for _, column := range columns {
+		if !slices.Contains(keys, column) {
+			s.Assign(column + " = " + UnnestAlias + "." + column)
+		}
+	}
+	s.From(source, alias)
+	for _, key := range keys {
+		s.AndWhere(table + key + " = " + UnnestAlias + "." + key)
+	}
+	return s
+}
+
+// fail keeps the first error of the updated rows, the statement fails to build until it is cleaned.
+func (s *UpdateStmt) fail(err error) {
+	if s.valuesErr == nil {
+		s.valuesErr = err
+	}
+}
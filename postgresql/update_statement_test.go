@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"github.com/AlephTav/sqb"
+	cls "github.com/AlephTav/sqb/sql/clause"
 	sql "github.com/AlephTav/sqb/sql/expression"
 	"testing"
 )
@@ -418,3 +419,237 @@ func TestUpdateStmt_Clean(t *testing.T) {
 }
 
 //endregion
+
+//region WHEN AND SCOPE
+
+func TestUpdateStmt_When(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewUpdateStmt(nil).
+		Table("tb").
+		Assign("c1", 1).
+		When(false, func(s *UpdateStmt) *UpdateStmt { return s.Assign("c2", 2) }).
+		When(true, func(s *UpdateStmt) *UpdateStmt { return s.Where("c3", "=", 3) })
+
+	sqb.CheckSql(t, "UPDATE tb SET c1 = :p1 WHERE c3 = :p2", st.String())
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": 3}, st.Params())
+}
+
+//endregion
+
+//region TABLE SCOPES
+
+func TestUpdateStmt_TableScopes(t *testing.T) {
+	sqb.ResetParameterIndex()
+	scopes := cls.NewTableScopes().
+		Register("users", "tenant", func(table string) []any { return []any{table + ".tenant_id", "=", 7} })
+	st := NewUpdateStmt(nil).
+		WithTableScopes(scopes).
+		Table("users", "u").
+		Assign("name", "x").
+		From("users AS m").
+		Where("m.id = u.manager_id")
+
+	sqb.CheckSql(
+		t,
+		"UPDATE users u SET name = :p1 FROM users AS m WHERE (m.id = u.manager_id) AND u.tenant_id = :p2 AND m.tenant_id = :p3",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": "x", "p2": 7, "p3": 7}, st.Params())
+}
+
+//endregion
+
+//region UNNEST
+
+func TestUpdateStmt_ValuesUnnestRows(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewUpdateStmt(nil).
+		Table("users", "u").
+		ValuesUnnest(
+			sqb.Map("id", "int", "name", "text", "score", "numeric"),
+			[]map[string]any{{"id": 1, "name": "a", "score": 1.5}, {"id": 2, "name": "b", "score": nil}},
+		).
+		AndWhere("u.locked = false")
+
+	sqb.CheckSql(
+		t,
+		"UPDATE users u SET name = v.name, score = v.score "+
+			"FROM (SELECT unnest(:p1::int[]) AS id, unnest(:p2::text[]) AS name, unnest(:p3::numeric[]) AS score) v "+
+			"WHERE u.id = v.id AND u.locked = false",
+		st.String(),
+	)
+	sqb.CheckParams(
+		t,
+		map[string]any{"p1": []any{1, 2}, "p2": []any{"a", "b"}, "p3": []any{1.5, nil}},
+		st.Params(),
+	)
+}
+
+func TestUpdateStmt_ValuesUnnestColumnsWithCompositeKey(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewUpdateStmt(nil).
+		Table("stock").
+		ValuesUnnest(
+			sqb.Map("warehouse_id", "int", "sku", "text", "qty", "int"),
+			map[string]any{"warehouse_id": []int{1, 1}, "sku": []string{"a", "b"}, "qty": []int{10, 20}},
+			"warehouse_id", "sku",
+		).
+		Returning("stock.sku")
+
+	sqb.CheckSql(
+		t,
+		"UPDATE stock SET qty = v.qty "+
+			"FROM (SELECT unnest(:p1::int[]) AS warehouse_id, unnest(:p2::text[]) AS sku, unnest(:p3::int[]) AS qty) v "+
+			"WHERE stock.warehouse_id = v.warehouse_id AND stock.sku = v.sku RETURNING stock.sku",
+		st.String(),
+	)
+	sqb.CheckParams(
+		t,
+		map[string]any{"p1": []int{1, 1}, "p2": []string{"a", "b"}, "p3": []int{10, 20}},
+		st.Params(),
+	)
+}
+
+func TestUpdateStmt_ValuesUnnestUnknownColumns(t *testing.T) {
+	st := NewUpdateStmt(nil).
+		Table("users").
+		ValuesUnnest(sqb.Map("id", "int", "name", "text"), sqb.Map("id", []int{1}, "email", []string{"a"}))
+
+	expected := `unnest data has column "email" missing from the types of columns [id name]`
+	if err := st.Validate(); err == nil || err.Error() != expected {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	st = NewUpdateStmt(nil).
+		Table("users").
+		ValuesUnnest(sqb.Map("id", "int", "name", "text"), sqb.Map("id", []int{1}, "name", []string{"a"}), "uid")
+
+	expected = `key "uid" is not one of the updated columns [id name]`
+	if err := st.Validate(); err == nil || err.Error() != expected {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestUpdateStmt_ValuesUnnestMissingColumns(t *testing.T) {
+	types := sqb.Map("id", "int", "name", "text")
+	tests := []struct {
+		data     any
+		expected string
+	}{
+		{[]map[string]any{{"id": 1, "name": "a"}, {"id": 2}}, `unnest row 1 has no column "name"`},
+		{[]sqb.SliceMap{sqb.Map("name", "a"), sqb.Map("id", 2, "name", "b")}, `unnest row 0 has no column "id"`},
+		{map[string]any{"id": []int{1}}, `unnest data has no column "name"`},
+		{sqb.Map("name", []string{"a"}), `unnest data has no column "id"`},
+	}
+	for _, test := range tests {
+		st := NewUpdateStmt(nil).Table("users").ValuesUnnest(types, test.data)
+		if err := st.Validate(); err == nil || err.Error() != test.expected {
+			t.Errorf("Validate() error = %v, expected %q", err, test.expected)
+		}
+	}
+}
+
+//endregion
+
+//region BULK UPDATE
+
+type bulkUser struct {
+	ID        int `db:"id"`
+	FirstName string
+	Email     string `db:"email"`
+	Internal  string `db:"-"`
+}
+
+func TestUpdateStmt_BulkUpdateStructs(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewUpdateStmt(nil).
+		Table("users", "u").
+		BulkUpdate(
+			sqb.Map("id", "int", "first_name", "text", "email", "text"),
+			[]bulkUser{{ID: 1, FirstName: "a", Email: "x"}, {ID: 2, FirstName: "b"}},
+		)
+
+	sqb.CheckSql(
+		t,
+		"UPDATE users u SET first_name = v.first_name, email = v.email "+
+			"FROM (VALUES (:p1::int, :p2::text, :p3::text), (:p4, :p5, :p6)) AS v(id, first_name, email) WHERE u.id = v.id",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": "a", "p3": "x", "p4": 2, "p5": "b", "p6": ""}, st.Params())
+}
+
+func TestUpdateStmt_BulkUpdateMapsWithoutTypes(t *testing.T) {
+	sqb.ResetParameterIndex()
+	st := NewUpdateStmt(nil).
+		Table("stock").
+		BulkUpdate(
+			nil,
+			[]map[string]any{{"sku": "a", "qty": 1, "wh": 1}, {"sku": "b", "qty": 2, "wh": 1}},
+			"wh", "sku",
+		).
+		Where("stock.active")
+
+	sqb.CheckSql(
+		t,
+		"UPDATE stock SET qty = v.qty "+
+			"FROM (VALUES (:p1, :p2, :p3), (:p4, :p5, :p6)) AS v(qty, sku, wh) "+
+			"WHERE stock.wh = v.wh AND stock.sku = v.sku AND stock.active",
+		st.String(),
+	)
+	sqb.CheckParams(t, map[string]any{"p1": 1, "p2": "a", "p3": 1, "p4": 2, "p5": "b", "p6": 1}, st.Params())
+}
+
+func TestUpdateStmt_BulkUpdateErrors(t *testing.T) {
+	st := NewUpdateStmt(sqb.NewStatementExecutorMock()).
+		Table("users").
+		BulkUpdate(sqb.Map("id", "int", "name", "text"), []map[string]any{})
+
+	if _, err := st.Exec(); err == nil || err.Error() != "bulk update requires at least one row" {
+		t.Errorf("Unexpected error %v", err)
+	}
+
+	st = NewUpdateStmt(nil).
+		Table("users").
+		BulkUpdate(nil, []map[string]any{{"id": 1, "name": "a"}})
+
+	if err := st.Validate(); err == nil || err.Error() != "bulk update without types requires the key columns" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestUpdateStmt_BulkUpdateRowsWithOtherColumns(t *testing.T) {
+	tests := []struct {
+		types    sqb.SliceMap
+		rows     []map[string]any
+		expected string
+	}{
+		{
+			sqb.Map("id", "int", "name", "text"),
+			[]map[string]any{{"id": 1, "name": "a"}, {"id": 2}},
+			`bulk update row 2 has 1 columns, expected columns are [id name]`,
+		},
+		{
+			sqb.Map("id", "int", "name", "text"),
+			[]map[string]any{{"id": 1, "email": "a"}},
+			`bulk update row 1 has no column "name", expected columns are [id name]`,
+		},
+		{
+			sqb.Map("id", "int"),
+			[]map[string]any{{"id": 1, "name": "a"}},
+			`bulk update row 1 has 2 columns, expected columns are [id]`,
+		},
+		{
+			nil,
+			[]map[string]any{{"id": 1, "name": "a"}, {"id": 2, "email": "b"}},
+			`bulk update row 2 has no column "name", expected columns are [id name]`,
+		},
+	}
+	for _, test := range tests {
+		st := NewUpdateStmt(nil).Table("users").BulkUpdate(test.types, test.rows, "id")
+		if err := st.Validate(); err == nil || err.Error() != test.expected {
+			t.Errorf("Validate() error = %v, expected %q", err, test.expected)
+		}
+	}
+}
+
+//endregion
@@ -0,0 +1,106 @@
+package sqb
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// ToSliceMaps converts the rows to slice maps of columns and values. The rows can be a slice of
+// map[string]any, SliceMap, structs or pointers to structs. The keys of maps are sorted.
+func ToSliceMaps(rows any) []SliceMap {
+	switch rows.(type) {
+	case []SliceMap:
+		return rows.([]SliceMap)
+	case []map[string]any:
+		result := make([]SliceMap, 0, len(rows.([]map[string]any)))
+		for _, row := range rows.([]map[string]any) {
+			result = append(result, sortedSliceMap(row))
+		}
+		return result
+	}
+	value := reflect.ValueOf(rows)
+	if value.Kind() != reflect.Slice {
+		return nil
+	}
+	result := make([]SliceMap, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		result = append(result, RowToSliceMap(value.Index(i).Interface()))
+	}
+	return result
+}
+
+// RowToSliceMap converts the row to the slice map of columns and values:
+//   - RowToSliceMap(row map[string]any), the keys are sorted
+//   - RowToSliceMap(row SliceMap)
+//   - RowToSliceMap(row any), the row is a struct or a pointer to struct
+func RowToSliceMap(row any) SliceMap {
+	switch row.(type) {
+	case SliceMap:
+		return row.(SliceMap)
+	case map[string]any:
+		return sortedSliceMap(row.(map[string]any))
+	}
+	return StructToSliceMap(row)
+}
+
+// StructToSliceMap converts the exported fields of the struct to the slice map of columns and values.
+// The column name is taken from the "db" tag or is the snake case field name, the fields tagged with "-" are skipped.
+// The fields of embedded structs without the tag are added to the result.
+func StructToSliceMap(value any) SliceMap {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var result SliceMap
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, hasTag := field.Tag.Lookup("db")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" || !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		if field.Anonymous && !hasTag {
+			result = append(result, StructToSliceMap(v.Field(i).Interface())...)
+			continue
+		}
+		if name == "" {
+			name = snakeCase(field.Name)
+		}
+		result = append(result, name, v.Field(i).Interface())
+	}
+	return result
+}
+
+func sortedSliceMap(row map[string]any) SliceMap {
+	keys := MapKeys(row)
+	slices.Sort(keys)
+	result := make(SliceMap, 0, 2*len(keys))
+	for _, key := range keys {
+		result = append(result, key, row[key])
+	}
+	return result
+}
+
+func snakeCase(name string) string {
+	var result strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				result.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
@@ -10,6 +10,7 @@ type BaseStatement[T sqb.Statement[T]] struct {
 	db    sqb.StatementExecutor
 	self  T
 	built bool
+	err   error
 }
 
 func NewBaseStatement[T sqb.Statement[T]](self T, db sqb.StatementExecutor) *BaseStatement[T] {
@@ -18,6 +19,7 @@ func NewBaseStatement[T sqb.Statement[T]](self T, db sqb.StatementExecutor) *Bas
 		db,
 		self,
 		false,
+		nil,
 	}
 }
 
@@ -48,3 +50,51 @@ func (s *BaseStatement[T]) Dirty() T {
 	s.built = false
 	return s.self
 }
+
+// AddParams adds the parameters of the nested expression,
+// the error of the invalid nested statement fails the statement instead.
+func (s *BaseStatement[T]) AddParams(params map[string]any) {
+	s.Expression.AddParams(params)
+	if err := sql.PopNestedError(s.Expression.Params()); err != nil {
+		s.Fail(err)
+	}
+}
+
+// Clean removes the SQL, the parameters and the error of the previous build.
+func (s *BaseStatement[T]) Clean() {
+	s.Expression.Clean()
+	s.err = nil
+}
+
+// Fail marks the statement being built as invalid, the first error is kept until the next build.
+// The SQL of the invalid statement is still rendered, but the statement is not executed.
+func (s *BaseStatement[T]) Fail(err error) T {
+	if s.err == nil {
+		s.err = err
+	}
+	return s.self
+}
+
+// Validate builds the statement and returns the error that makes it invalid.
+func (s *BaseStatement[T]) Validate() error {
+	s.self.Build()
+	return s.err
+}
+
+// When applies the callback to the statement if the condition is true, otherwise it applies the fallback if any:
+//   - When(condition bool, callback Scope[T])
+//   - When(condition bool, callback Scope[T], fallback Scope[T])
+func (s *BaseStatement[T]) When(condition bool, callback Scope[T], args ...Scope[T]) T {
+	if condition {
+		return callback(s.self)
+	}
+	if len(args) > 0 && args[0] != nil {
+		return args[0](s.self)
+	}
+	return s.self
+}
+
+// Scope applies the scopes to the statement in the given order.
+func (s *BaseStatement[T]) Scope(scopes ...Scope[T]) T {
+	return Scopes(scopes...)(s.self)
+}
@@ -34,9 +34,16 @@ func (a *AssignmentClause[T]) CopyAssignment(self T) *AssignmentClause[T] {
 }
 
 func (a *AssignmentClause[T]) BuildAssignment() T {
+	return a.BuildAssignmentAs("SET")
+}
+
+// BuildAssignmentAs adds the assignments to the statement after the given keyword, e.g. UPDATE of ClickHouse mutations.
+func (a *AssignmentClause[T]) BuildAssignmentAs(keyword string) T {
 	if a.exp.IsNotEmpty() {
 		a.self.AddParams(a.exp.Params())
-		a.self.AddSql(" SET ")
+		a.self.AddSql(" ")
+		a.self.AddSql(keyword)
+		a.self.AddSql(" ")
 		a.self.AddSql(a.exp.String())
 	}
 	return a.self
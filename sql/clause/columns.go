@@ -20,6 +20,10 @@ func (c *ColumnsClause[T]) Columns(columns any) T {
 	return c.self
 }
 
+func (c *ColumnsClause[T]) HasColumns() bool {
+	return c.exp.IsNotEmpty()
+}
+
 func (c *ColumnsClause[T]) CleanColumns() T {
 	c.exp.Clean()
 	c.self.Dirty()
@@ -6,12 +6,13 @@ import (
 )
 
 type DeleteClause[T sqb.Statement[T]] struct {
-	self T
-	exp  sql.DirectListExpression
+	self   T
+	exp    sql.DirectListExpression
+	tables []TableRef
 }
 
 func NewDeleteClause[T sqb.Statement[T]](self T) *DeleteClause[T] {
-	return &DeleteClause[T]{self, sql.EmptyDirectListExp()}
+	return &DeleteClause[T]{self, sql.EmptyDirectListExp(), nil}
 }
 
 // From adds table name and its alias to the delete clause:
@@ -19,18 +20,25 @@ func NewDeleteClause[T sqb.Statement[T]](self T) *DeleteClause[T] {
 //   - From(table any, alias any)
 func (d *DeleteClause[T]) From(table any, args ...any) T {
 	d.exp.Append(table, args...)
+	d.tables = append(d.tables, tableRefs(table, alias(args))...)
 	d.self.Dirty()
 	return d.self
 }
 
+// DeleteTables returns the tables listed in the delete clause.
+func (d *DeleteClause[T]) DeleteTables() []TableRef {
+	return d.tables
+}
+
 func (d *DeleteClause[T]) CleanDelete() T {
 	d.exp.Clean()
+	d.tables = nil
 	d.self.Dirty()
 	return d.self
 }
 
 func (d *DeleteClause[T]) CopyDelete(self T) *DeleteClause[T] {
-	return &DeleteClause[T]{self, d.exp.Copy()}
+	return &DeleteClause[T]{self, d.exp.Copy(), append([]TableRef(nil), d.tables...)}
 }
 
 func (d *DeleteClause[T]) BuildDelete() (T, sql.DirectListExpression) {
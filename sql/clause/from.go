@@ -6,13 +6,14 @@ import (
 )
 
 type FromClause[T sqb.Statement[T]] struct {
-	self  T
-	exp   sql.DirectListExpression
-	final bool
+	self   T
+	exp    sql.DirectListExpression
+	final  bool
+	tables []TableRef
 }
 
 func NewFromClause[T sqb.Statement[T]](self T) *FromClause[T] {
-	return &FromClause[T]{self, sql.EmptyDirectListExp(), false}
+	return &FromClause[T]{self, sql.EmptyDirectListExp(), false, nil}
 }
 
 // From adds table name and its alias to the "from" clause:
@@ -20,6 +21,7 @@ func NewFromClause[T sqb.Statement[T]](self T) *FromClause[T] {
 //   - From(table any, alias any)
 func (f *FromClause[T]) From(table any, args ...any) T {
 	f.exp.Append(table, args...)
+	f.tables = append(f.tables, tableRefs(table, alias(args))...)
 	f.self.Dirty()
 	return f.self
 }
@@ -31,16 +33,22 @@ func (f *FromClause[T]) Final() T {
 	return f.self
 }
 
+// FromTables returns the tables listed in the "from" clause.
+func (f *FromClause[T]) FromTables() []TableRef {
+	return f.tables
+}
+
 func (f *FromClause[T]) CleanFrom() T {
 	f.exp.Clean()
 	f.final = false
+	f.tables = nil
 
 	f.self.Dirty()
 	return f.self
 }
 
 func (f *FromClause[T]) CopyFrom(self T) *FromClause[T] {
-	return &FromClause[T]{self, f.exp.Copy(), f.final}
+	return &FromClause[T]{self, f.exp.Copy(), f.final, append([]TableRef(nil), f.tables...)}
 }
 
 func (f *FromClause[T]) BuildFrom() T {
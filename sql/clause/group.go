@@ -1,18 +1,20 @@
 package sql
 
 import (
+	"errors"
+
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/expression"
 )
 
 type GroupClause[T sqb.Statement[T]] struct {
-	self   T
-	exp    sql.ReversedListExpression
-	rollup bool
+	self T
+	exp  sql.ReversedListExpression
+	err  error
 }
 
 func NewGroupClause[T sqb.Statement[T]](self T) *GroupClause[T] {
-	return &GroupClause[T]{self, sql.EmptyReversedListExp(), false}
+	return &GroupClause[T]{self, sql.EmptyReversedListExp(), nil}
 }
 
 // GroupBy adds column name and its order to the group clause:
@@ -24,27 +26,54 @@ func (g *GroupClause[T]) GroupBy(column any, args ...any) T {
 	return g.self
 }
 
+// Rollup adds the ROLLUP grouping element to the group clause, e.g. ROLLUP(a, b),
+// the statement fails if there are no columns:
+//   - Rollup(columns ...any)
+func (g *GroupClause[T]) Rollup(columns ...any) T {
+	if len(columns) == 0 && g.err == nil {
+		g.err = errors.New("ROLLUP requires at least one column")
+	}
+	return g.GroupBy(sql.Rollup(columns...))
+}
+
+// Cube adds the CUBE grouping element to the group clause, e.g. CUBE(a, b),
+// the statement fails if there are no columns:
+//   - Cube(columns ...any)
+func (g *GroupClause[T]) Cube(columns ...any) T {
+	if len(columns) == 0 && g.err == nil {
+		g.err = errors.New("CUBE requires at least one column")
+	}
+	return g.GroupBy(sql.Cube(columns...))
+}
+
+// GroupingSets adds the GROUPING SETS grouping element to the group clause, e.g. GROUPING SETS ((a), (a, b), ()):
+//   - GroupingSets(sets ...any), every set is a column, an expression or a slice of them, nil is the empty set
+func (g *GroupClause[T]) GroupingSets(sets ...any) T {
+	return g.GroupBy(sql.GroupingSets(sets...))
+}
+
+func (g *GroupClause[T]) HasGroup() bool {
+	return g.exp.IsNotEmpty()
+}
+
 func (g *GroupClause[T]) CleanGroup() T {
 	g.exp.Clean()
+	g.err = nil
 	g.self.Dirty()
 	return g.self
 }
 
 func (g *GroupClause[T]) CopyGroup(self T) *GroupClause[T] {
-	return &GroupClause[T]{self, g.exp.Copy(), false}
-}
-func (f *GroupClause[T]) Rollup() T {
-	f.rollup = true
-	f.self.Dirty()
-	return f.self
+	return &GroupClause[T]{self, g.exp.Copy(), g.err}
 }
+
 func (g *GroupClause[T]) BuildGroup() T {
+	if g.err != nil {
+		sqb.Fail(g.self, g.err)
+	}
 	if g.exp.IsNotEmpty() {
 		g.self.AddParams(g.exp.Params())
 		g.self.AddSql(" GROUP BY ")
-		if g.rollup {
-			g.self.AddSql(" ROLLUP")
-		}
 		g.self.AddSql(g.exp.String())
 	}
 	return g.self
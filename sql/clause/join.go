@@ -1,17 +1,58 @@
 package sql
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/AlephTav/sqb"
 	sql "github.com/AlephTav/sqb/sql/expression"
 )
 
+type joinItem struct {
+	joinType string
+	table    any
+	args     []any
+}
+
+// split returns the alias and condition of the join.
+func (i joinItem) split() (any, any) {
+	switch len(i.args) {
+	case 0:
+		return nil, nil
+	case 1:
+		return nil, i.args[0]
+	default:
+		return i.args[0], i.args[1]
+	}
+}
+
+func (i joinItem) hasOnCondition() bool {
+	_, condition := i.split()
+	switch condition.(type) {
+	case string, sql.Expression, sql.ConditionalExpression:
+		return true
+	default:
+		return false
+	}
+}
+
+// isOuter reports whether the join keeps the rows of a table that have no match in the joined table.
+func (i joinItem) isOuter() bool {
+	for _, word := range strings.Fields(strings.ToUpper(i.joinType)) {
+		if word == "LEFT" || word == "RIGHT" || word == "FULL" {
+			return true
+		}
+	}
+	return false
+}
+
 type JoinClause[T sqb.Statement[T]] struct {
-	self T
-	exp  sql.JoinExpression
+	self  T
+	items []joinItem
 }
 
 func NewJoinClause[T sqb.Statement[T]](self T) *JoinClause[T] {
-	return &JoinClause[T]{self, sql.EmptyJoinExp()}
+	return &JoinClause[T]{self, nil}
 }
 
 // CrossJoin adds cross join on new table with alias and condition:
@@ -95,26 +136,91 @@ func (j *JoinClause[T]) NaturalRightOuterJoin(table any, args ...any) T {
 //   - Join(joinType string, table any, condition any)
 //   - Join(joinType string, table any, alias any, condition any)
 func (j *JoinClause[T]) Join(joinType string, table any, args ...any) T {
-	j.exp.Append(joinType, table, args...)
+	j.items = append(j.items, joinItem{joinType, table, args})
 	j.self.Dirty()
 	return j.self
 }
 
+// JoinWhereTables returns the tables of inner joins whose default conditions cannot be added to the ON clause,
+// e.g. tables of cross and natural joins or joins with USING clause.
+// The default conditions of such tables can be added to the WHERE clause without changing the result.
+func (j *JoinClause[T]) JoinWhereTables() []TableRef {
+	var tables []TableRef
+	for _, item := range j.items {
+		if !item.hasOnCondition() && !item.isOuter() {
+			alias, _ := item.split()
+			tables = append(tables, tableRefs(item.table, alias)...)
+		}
+	}
+	return tables
+}
+
 func (j *JoinClause[T]) CleanJoin() T {
-	j.exp.Clean()
+	j.items = nil
 	j.self.Dirty()
 	return j.self
 }
 
 func (j *JoinClause[T]) CopyJoin(self T) *JoinClause[T] {
-	return &JoinClause[T]{self, j.exp.Copy()}
-}
-
-func (j *JoinClause[T]) BuildJoin() T {
-	if j.exp.IsNotEmpty() {
-		j.self.AddParams(j.exp.Params())
+	return &JoinClause[T]{self, append([]joinItem(nil), j.items...)}
+}
+
+// BuildJoin adds the joins to the statement, the conditions returned by the table scope are appended to the ON clause:
+//   - BuildJoin()
+//   - BuildJoin(scope func(table TableRef) [][]any)
+//
+// The statement fails if the joined table of an outer join without ON clause, e.g. LEFT JOIN ... USING,
+// has default conditions since they can be neither added to the ON clause nor moved to the WHERE clause.
+func (j *JoinClause[T]) BuildJoin(args ...func(table TableRef) [][]any) T {
+	exp := sql.EmptyJoinExp()
+	for _, item := range j.items {
+		if len(args) > 0 && args[0] != nil && item.hasOnCondition() {
+			exp.Append(item.joinType, item.table, j.scopedArgs(item, args[0])...)
+		} else {
+			if len(args) > 0 && args[0] != nil && item.isOuter() {
+				j.checkOuterJoin(item, args[0])
+			}
+			exp.Append(item.joinType, item.table, item.args...)
+		}
+	}
+	if exp.IsNotEmpty() {
+		j.self.AddParams(exp.Params())
 		j.self.AddSql(" ")
-		j.self.AddSql(j.exp.String())
+		j.self.AddSql(exp.String())
 	}
 	return j.self
 }
+
+func (j *JoinClause[T]) checkOuterJoin(item joinItem, scope func(table TableRef) [][]any) {
+	alias, _ := item.split()
+	for _, ref := range tableRefs(item.table, alias) {
+		if len(scope(ref)) > 0 {
+			sqb.Fail(j.self, fmt.Errorf("cannot apply scopes of table %q to %s without ON condition", ref.Name, item.joinType))
+			return
+		}
+	}
+}
+
+func (j *JoinClause[T]) scopedArgs(item joinItem, scope func(table TableRef) [][]any) []any {
+	alias, condition := item.split()
+	var conditions [][]any
+	for _, ref := range tableRefs(item.table, alias) {
+		conditions = append(conditions, scope(ref)...)
+	}
+	if len(conditions) == 0 {
+		return item.args
+	}
+	cond := sql.EmptyCondExp()
+	if c, ok := condition.(sql.ConditionalExpression); ok {
+		cond.Where(c)
+	} else {
+		cond.Where(sql.NewCondExp(condition))
+	}
+	for _, c := range conditions {
+		cond.AndWhere(c...)
+	}
+	if len(item.args) > 1 {
+		return []any{alias, cond.Expression}
+	}
+	return []any{cond.Expression}
+}
@@ -7,14 +7,12 @@ import (
 )
 
 type LimitClause[T sqb.Statement[T]] struct {
-	self     T
-	limit    int
-	withties bool
-	by       string
+	self  T
+	limit int
 }
 
 func NewLimitClause[T sqb.Statement[T]](self T) *LimitClause[T] {
-	return &LimitClause[T]{self, -1, false, ""}
+	return &LimitClause[T]{self, -1}
 }
 
 func (l *LimitClause[T]) Limit(limit int) T {
@@ -23,6 +21,11 @@ func (l *LimitClause[T]) Limit(limit int) T {
 	return l.self
 }
 
+// HasLimit returns true if the limit is set.
+func (l *LimitClause[T]) HasLimit() bool {
+	return l.limit >= 0
+}
+
 func (l *LimitClause[T]) CleanLimit() T {
 	l.limit = -1
 	l.self.Dirty()
@@ -30,31 +33,12 @@ func (l *LimitClause[T]) CleanLimit() T {
 }
 
 func (l *LimitClause[T]) CopyLimit(self T) *LimitClause[T] {
-	return &LimitClause[T]{self, l.limit, l.withties, l.by}
-}
-
-func (l *LimitClause[T]) WithTies() T {
-	l.withties = true
-	l.self.Dirty()
-	return l.self
-}
-
-func (l *LimitClause[T]) By(column string) T {
-	l.by = column
-	l.self.Dirty()
-	return l.self
+	return &LimitClause[T]{self, l.limit}
 }
 
 func (l *LimitClause[T]) BuildLimit() T {
 	if l.limit >= 0 {
 		l.self.AddSql(" LIMIT " + strconv.Itoa(l.limit))
-		if l.withties {
-			l.self.AddSql(" WITH TIES")
-		}
-		if l.by != "" {
-			l.self.AddSql(" BY " + l.by)
-		}
 	}
-
 	return l.self
 }
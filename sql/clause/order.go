@@ -23,6 +23,15 @@ func (o *OrderClause[T]) OrderBy(column any, args ...any) T {
 	return o.self
 }
 
+// OrderItems returns the items of the order clause, e.g. ["a DESC", "b"].
+func (o *OrderClause[T]) OrderItems() []string {
+	return sql.SplitList(o.exp.String())
+}
+
+func (o *OrderClause[T]) HasOrder() bool {
+	return o.exp.IsNotEmpty()
+}
+
 func (o *OrderClause[T]) CleanOrder() T {
 	o.exp.Clean()
 	o.self.Dirty()
@@ -27,6 +27,10 @@ func (r *ReturningClause[T]) Returning(column any, args ...any) T {
 	return r.self
 }
 
+func (r *ReturningClause[T]) HasReturning() bool {
+	return r.exp.IsNotEmpty()
+}
+
 func (r *ReturningClause[T]) CleanReturning() T {
 	r.exp.Clean()
 	r.self.Dirty()
@@ -36,22 +36,32 @@ func (s *SelectClause[T]) All() T {
 }
 func (s *SelectClause[T]) CleanSelect() T {
 	s.exp.Clean()
+	s.distinct = false
+	s.all = false
 	s.self.Dirty()
 	return s.self
 }
 
 func (s *SelectClause[T]) CopySelect(self T) *SelectClause[T] {
-	return &SelectClause[T]{self, s.exp.Copy(), false, false}
+	return &SelectClause[T]{self, s.exp.Copy(), s.distinct, s.all}
 }
 
 func (s *SelectClause[T]) BuildSelect() T {
+	return s.BuildSelectWith("")
+}
 
+// BuildSelectWith builds the select clause adding the dialect specific modifier after DISTINCT, e.g. "ON (a)".
+func (s *SelectClause[T]) BuildSelectWith(distinctModifier string) T {
+	distinct := "SELECT DISTINCT"
+	if distinctModifier != "" {
+		distinct += " " + distinctModifier
+	}
 	if s.exp.IsEmpty() {
 		if s.all {
 			s.self.AddSql("SELECT ALL")
 		} else {
 			if s.distinct {
-				s.self.AddSql("SELECT DISTINCT *")
+				s.self.AddSql(distinct + " *")
 			} else {
 				s.self.AddSql("SELECT *")
 			}
@@ -59,7 +69,7 @@ func (s *SelectClause[T]) BuildSelect() T {
 	} else {
 		s.self.AddParams(s.exp.Params())
 		if s.distinct {
-			s.self.AddSql("SELECT DISTINCT ")
+			s.self.AddSql(distinct + " ")
 		} else {
 			s.self.AddSql("SELECT ")
 		}
@@ -0,0 +1,334 @@
+package sql
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
+
+// TableRef is a table referenced by a statement.
+type TableRef struct {
+	Name  string
+	Alias string
+	// unresolved is true if Name is the SQL text of the table reference that could not be parsed,
+	// e.g. a function call or a raw expression.
+	unresolved bool
+}
+
+func unresolvedTableRef(table string) TableRef {
+	return TableRef{table, "", true}
+}
+
+// Ref returns the name the table is referenced by in the statement.
+func (r TableRef) Ref() string {
+	if r.Alias != "" {
+		return r.Alias
+	}
+	return r.Name
+}
+
+func tableRefs(table any, alias any) []TableRef {
+	switch table.(type) {
+	case string:
+		return stringToTableRefs(table.(string), alias)
+	case sqb.SliceMap:
+		var refs []TableRef
+		tables := table.(sqb.SliceMap)
+		for i, count := 0, len(tables); i < count; i += 2 {
+			refs = append(refs, tableRefs(tables[i+1], tables[i])...)
+		}
+		return refs
+	case map[string]any:
+		var refs []TableRef
+		tables := table.(map[string]any)
+		aliases := make([]string, 0, len(tables))
+		for a := range tables {
+			aliases = append(aliases, a)
+		}
+		slices.Sort(aliases)
+		for _, a := range aliases {
+			refs = append(refs, tableRefs(tables[a], a)...)
+		}
+		return refs
+	case []any:
+		var refs []TableRef
+		for _, item := range table.([]any) {
+			if pair, ok := item.([]any); ok && len(pair) == 2 {
+				refs = append(refs, tableRefs(pair[1], pair[0])...)
+			} else {
+				refs = append(refs, tableRefs(item, nil)...)
+			}
+		}
+		return refs
+	case sql.LateralExpression:
+		return tableRefs(table.(sql.LateralExpression).Source(), alias)
+	case sqb.Query:
+		// The subquery applies the scopes of its own tables when it is built.
+		return nil
+	case fmt.Stringer:
+		return []TableRef{unresolvedTableRef(table.(fmt.Stringer).String())}
+	default:
+		return nil
+	}
+}
+
+func stringToTableRefs(table string, alias any) []TableRef {
+	if strings.ContainsAny(table, "()") {
+		return []TableRef{unresolvedTableRef(table)}
+	}
+	var aliasName string
+	if a, ok := alias.(string); ok && a != "" {
+		aliasName = a
+	} else if a, ok := alias.(sql.TableAliasExpression); ok {
+		aliasName = a.Name()
+	}
+	if aliasName != "" {
+		if name, ok := unquoteIdentifier(strings.TrimSpace(table)); ok {
+			return []TableRef{{name, aliasName, false}}
+		}
+		return []TableRef{unresolvedTableRef(table)}
+	}
+	var refs []TableRef
+	for _, tb := range strings.Split(table, ",") {
+		parts := strings.Fields(tb)
+		if len(parts) == 3 && strings.EqualFold(parts[1], "AS") {
+			parts = []string{parts[0], parts[2]}
+		}
+		name, ok := "", false
+		if len(parts) == 1 || len(parts) == 2 {
+			name, ok = unquoteIdentifier(parts[0])
+		}
+		switch {
+		case !ok:
+			refs = append(refs, unresolvedTableRef(tb))
+		case len(parts) == 1:
+			refs = append(refs, TableRef{name, "", false})
+		default:
+			refs = append(refs, TableRef{name, parts[1], false})
+		}
+	}
+	return refs
+}
+
+// unquoteIdentifier returns the possibly qualified table name without double quotes,
+// it returns false if the name cannot be parsed, e.g. it contains escaped quotes.
+func unquoteIdentifier(name string) (string, bool) {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		if len(part) > 1 && part[0] == '"' && part[len(part)-1] == '"' {
+			part = part[1 : len(part)-1]
+		}
+		if part == "" || strings.ContainsRune(part, '"') {
+			return "", false
+		}
+		parts[i] = part
+	}
+	return strings.Join(parts, "."), true
+}
+
+// TableScope returns the arguments of the condition that is applied to every statement using the table.
+// The argument is the name the table is referenced by in the statement.
+type TableScope func(table string) []any
+
+// SoftDeleteScope returns the scope that excludes the rows marked as deleted in the given column.
+func SoftDeleteScope(column string) TableScope {
+	return func(table string) []any {
+		return []any{table + "." + column + " IS NULL"}
+	}
+}
+
+type namedTableScope struct {
+	name  string
+	scope TableScope
+}
+
+// TableScopes is a registry of the default conditions of tables.
+type TableScopes struct {
+	mu     sync.RWMutex
+	scopes map[string][]namedTableScope
+}
+
+// DefaultTableScopes is the registry used by statements unless another registry is set.
+var DefaultTableScopes = NewTableScopes()
+
+func NewTableScopes() *TableScopes {
+	return &TableScopes{scopes: make(map[string][]namedTableScope)}
+}
+
+// RegisterTableScope adds the named scope of the table to the default registry.
+func RegisterTableScope(table, name string, scope TableScope) {
+	DefaultTableScopes.Register(table, name, scope)
+}
+
+// Register adds the named scope of the table, the scope with the same name is replaced.
+func (r *TableScopes) Register(table, name string, scope TableScope) *TableScopes {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, item := range r.scopes[table] {
+		if item.name == name {
+			r.scopes[table][i].scope = scope
+			return r
+		}
+	}
+	r.scopes[table] = append(r.scopes[table], namedTableScope{name, scope})
+	return r
+}
+
+// Remove removes the named scope of the table.
+func (r *TableScopes) Remove(table, name string) *TableScopes {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	items := r.scopes[table]
+	for i, item := range items {
+		if item.name == name {
+			r.scopes[table] = append(items[:i:i], items[i+1:]...)
+			break
+		}
+	}
+	return r
+}
+
+// Copy returns the registry with the same scopes, e.g. to extend it with request specific scopes.
+func (r *TableScopes) Copy() *TableScopes {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	scopes := make(map[string][]namedTableScope, len(r.scopes))
+	for table, items := range r.scopes {
+		scopes[table] = append([]namedTableScope(nil), items...)
+	}
+	return &TableScopes{scopes: scopes}
+}
+
+func (r *TableScopes) conditions(table TableRef, excluded []string) ([][]any, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if table.unresolved {
+		for name, items := range r.scopes {
+			if len(items) > 0 && mentionsTable(table.Name, name) {
+				return nil, fmt.Errorf("cannot apply scopes of table %q to the table reference %q", name, table.Name)
+			}
+		}
+		return nil, nil
+	}
+	items := r.scopes[table.Name]
+	if i := strings.LastIndexByte(table.Name, '.'); i >= 0 {
+		items = append(items[:len(items):len(items)], r.scopes[table.Name[i+1:]]...)
+	}
+	var conditions [][]any
+	for _, item := range items {
+		if !slices.Contains(excluded, item.name) {
+			conditions = append(conditions, item.scope(table.Ref()))
+		}
+	}
+	return conditions, nil
+}
+
+// mentionsTable reports whether the SQL text contains the table name as a separate word, case-insensitively.
+func mentionsTable(sqlText, table string) bool {
+	text, name := strings.ToLower(sqlText), strings.ToLower(table)
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+	isWordChar := func(c byte) bool {
+		return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c >= 0x80
+	}
+	for offset := 0; ; {
+		i := strings.Index(text[offset:], name)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(name)
+		if (start == 0 || !isWordChar(text[start-1])) && (end == len(text) || !isWordChar(text[end])) {
+			return true
+		}
+		offset = start + 1
+	}
+}
+
+// TableScopeClause applies the registered default conditions of tables to the statement.
+// The scopes are applied by PostgreSQL SELECT, UPDATE and DELETE statements only, ClickHouse statements ignore them.
+// The registry and the Unscoped settings of the statement are not passed down to its subqueries:
+// every subquery applies the scopes of its own tables using its own settings, and its error fails the statement.
+// If a table reference cannot be parsed, e.g. it is a raw expression or a function call, and it mentions a table
+// that has scopes, the statement fails to build instead of silently skipping the scopes.
+type TableScopeClause[T sqb.Statement[T]] struct {
+	self     T
+	scopes   *TableScopes
+	unscoped bool
+	excluded []string
+}
+
+func NewTableScopeClause[T sqb.Statement[T]](self T) *TableScopeClause[T] {
+	return &TableScopeClause[T]{self, nil, false, nil}
+}
+
+// WithTableScopes sets the registry of table scopes used by the statement instead of the default one.
+func (c *TableScopeClause[T]) WithTableScopes(scopes *TableScopes) T {
+	c.scopes = scopes
+	c.self.Dirty()
+	return c.self
+}
+
+// Unscoped turns off the default conditions of tables:
+//   - Unscoped() turns off all scopes
+//   - Unscoped(names ...string) turns off the named scopes only
+func (c *TableScopeClause[T]) Unscoped(names ...string) T {
+	if len(names) == 0 {
+		c.unscoped = true
+	} else {
+		c.excluded = append(c.excluded, names...)
+	}
+	c.self.Dirty()
+	return c.self
+}
+
+// TableConditions returns the default conditions of the table, every condition is the list of Where arguments.
+// The statement fails if the scopes cannot be applied to the table reference.
+func (c *TableScopeClause[T]) TableConditions(table TableRef) [][]any {
+	if c.unscoped {
+		return nil
+	}
+	scopes := c.scopes
+	if scopes == nil {
+		scopes = DefaultTableScopes
+	}
+	conditions, err := scopes.conditions(table, c.excluded)
+	if err != nil {
+		sqb.Fail(c.self, err)
+	}
+	return conditions
+}
+
+// ScopeConditions returns the default conditions of all given tables.
+func (c *TableScopeClause[T]) ScopeConditions(tables ...[]TableRef) [][]any {
+	var conditions [][]any
+	for _, refs := range tables {
+		for _, ref := range refs {
+			conditions = append(conditions, c.TableConditions(ref)...)
+		}
+	}
+	return conditions
+}
+
+func (c *TableScopeClause[T]) CleanTableScope() T {
+	c.scopes = nil
+	c.unscoped = false
+	c.excluded = nil
+	c.self.Dirty()
+	return c.self
+}
+
+func (c *TableScopeClause[T]) CopyTableScope(self T) *TableScopeClause[T] {
+	return &TableScopeClause[T]{self, c.scopes, c.unscoped, append([]string(nil), c.excluded...)}
+}
+
+func alias(args []any) any {
+	if len(args) > 0 {
+		return args[0]
+	}
+	return nil
+}
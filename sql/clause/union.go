@@ -1,6 +1,9 @@
 package sql
 
-import "github.com/AlephTav/sqb"
+import (
+	"github.com/AlephTav/sqb"
+	sql "github.com/AlephTav/sqb/sql/expression"
+)
 
 type UnionQuery struct {
 	UnionType string
@@ -51,7 +54,7 @@ func (u *UnionClause[T]) BuildUnion() T {
 		u.self.AddSql("(")
 		u.self.AddSql(item.Query.String())
 		u.self.AddSql(")")
-		u.self.AddParams(item.Query.Params())
+		u.self.AddParams(sql.NestedParams(item.Query))
 		notFirst = true
 	}
 	return u.self
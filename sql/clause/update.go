@@ -6,12 +6,13 @@ import (
 )
 
 type UpdateClause[T sqb.Statement[T]] struct {
-	self T
-	exp  sql.DirectListExpression
+	self   T
+	exp    sql.DirectListExpression
+	tables []TableRef
 }
 
 func NewUpdateClause[T sqb.Statement[T]](self T) *UpdateClause[T] {
-	return &UpdateClause[T]{self, sql.EmptyDirectListExp()}
+	return &UpdateClause[T]{self, sql.EmptyDirectListExp(), nil}
 }
 
 // Table adds table name and its alias to the update clause:
@@ -19,18 +20,25 @@ func NewUpdateClause[T sqb.Statement[T]](self T) *UpdateClause[T] {
 //   - Table(table any, alias any)
 func (u *UpdateClause[T]) Table(table any, args ...any) T {
 	u.exp.Append(table, args...)
+	u.tables = append(u.tables, tableRefs(table, alias(args))...)
 	u.self.Dirty()
 	return u.self
 }
 
+// UpdateTables returns the tables listed in the update clause.
+func (u *UpdateClause[T]) UpdateTables() []TableRef {
+	return u.tables
+}
+
 func (u *UpdateClause[T]) CleanUpdate() T {
 	u.exp.Clean()
+	u.tables = nil
 	u.self.Dirty()
 	return u.self
 }
 
 func (u *UpdateClause[T]) CopyUpdate(self T) *UpdateClause[T] {
-	return &UpdateClause[T]{self, u.exp.Copy()}
+	return &UpdateClause[T]{self, u.exp.Copy(), append([]TableRef(nil), u.tables...)}
 }
 
 func (u *UpdateClause[T]) BuildUpdate() (T, sql.DirectListExpression) {
@@ -6,12 +6,13 @@ import (
 )
 
 type UsingClause[T sqb.Statement[T]] struct {
-	self T
-	exp  sql.DirectListExpression
+	self   T
+	exp    sql.DirectListExpression
+	tables []TableRef
 }
 
 func NewUsingClause[T sqb.Statement[T]](self T) *UsingClause[T] {
-	return &UsingClause[T]{self, sql.EmptyDirectListExp()}
+	return &UsingClause[T]{self, sql.EmptyDirectListExp(), nil}
 }
 
 // Using adds table name and its alias to the using clause:
@@ -19,18 +20,35 @@ func NewUsingClause[T sqb.Statement[T]](self T) *UsingClause[T] {
 //   - Using(table any, alias any)
 func (u *UsingClause[T]) Using(table any, args ...any) T {
 	u.exp.Append(table, args...)
+	u.tables = append(u.tables, tableRefs(table, alias(args))...)
 	u.self.Dirty()
 	return u.self
 }
 
+// UsingTables returns the tables listed in the using clause.
+func (u *UsingClause[T]) UsingTables() []TableRef {
+	return u.tables
+}
+
 func (u *UsingClause[T]) CleanUsing() T {
 	u.exp.Clean()
+	u.tables = nil
 	u.self.Dirty()
 	return u.self
 }
 
 func (u *UsingClause[T]) CopyUsing(self T) *UsingClause[T] {
-	return &UsingClause[T]{self, u.exp.Copy()}
+	return &UsingClause[T]{self, u.exp.Copy(), append([]TableRef(nil), u.tables...)}
+}
+
+// BuildUsingAsFrom adds the tables of the using clause to the statement as the FROM clause.
+func (u *UsingClause[T]) BuildUsingAsFrom() T {
+	if u.exp.IsNotEmpty() {
+		u.self.AddParams(u.exp.Params())
+		u.self.AddSql(" FROM ")
+		u.self.AddSql(u.exp.String())
+	}
+	return u.self
 }
 
 func (u *UsingClause[T]) BuildUsing() T {
@@ -50,6 +50,10 @@ func (w *WhereClause[T]) Where(args ...any) T {
 	return w.self
 }
 
+func (w *WhereClause[T]) HasWhere() bool {
+	return w.exp.IsNotEmpty()
+}
+
 func (w *WhereClause[T]) CleanWhere() T {
 	w.exp.Clean()
 	w.self.Dirty()
@@ -60,11 +64,24 @@ func (w *WhereClause[T]) CopyWhere(self T) *WhereClause[T] {
 	return &WhereClause[T]{self, w.exp.Copy()}
 }
 
-func (w *WhereClause[T]) BuildWhere() T {
-	if w.exp.IsNotEmpty() {
-		w.self.AddParams(w.exp.Params())
+// BuildWhere adds the WHERE clause to the statement, the given conditions are appended to the clause with "AND":
+//   - BuildWhere()
+//   - BuildWhere(conditions ...[]any)
+func (w *WhereClause[T]) BuildWhere(conditions ...[]any) T {
+	exp := w.exp
+	if len(conditions) > 0 {
+		exp = sql.EmptyCondExp()
+		if w.exp.IsNotEmpty() {
+			exp.Where(w.exp)
+		}
+		for _, condition := range conditions {
+			exp.AndWhere(condition...)
+		}
+	}
+	if exp.IsNotEmpty() {
+		w.self.AddParams(exp.Params())
 		w.self.AddSql(" WHERE ")
-		w.self.AddSql(w.exp.String())
+		w.self.AddSql(exp.String())
 	}
 	return w.self
 }
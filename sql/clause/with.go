@@ -17,6 +17,7 @@ func NewWithClause[T sqb.Statement[T]](self T) *WithClause[T] {
 // With adds query to the with clause:
 // With(query any)
 // With(query any, alias any)
+// With(cte *sql.CteExpression)
 func (w *WithClause[T]) With(query any, args ...any) T {
 	w.exp.Append(false, query, args...)
 	w.self.Dirty()
@@ -26,6 +27,7 @@ func (w *WithClause[T]) With(query any, args ...any) T {
 // WithRecursive adds recursive query to the with clause:
 // WithRecursive(query any)
 // WithRecursive(query any, alias any)
+// WithRecursive(cte *sql.CteExpression)
 func (w *WithClause[T]) WithRecursive(query any, args ...any) T {
 	w.exp.Append(true, query, args...)
 	w.self.Dirty()
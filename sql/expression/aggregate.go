@@ -0,0 +1,146 @@
+package sql
+
+import "strings"
+
+// AggregateExpression is the builder of the aggregate function call with its DISTINCT modifier,
+// in-aggregate ORDER BY, WITHIN GROUP and FILTER clauses, e.g.
+// string_agg(DISTINCT name, ',' ORDER BY name) FILTER (WHERE active).
+type AggregateExpression struct {
+	function string
+	distinct bool
+	args     DirectListExpression
+	order    ReversedListExpression
+	within   ReversedListExpression
+	filter   ConditionalExpression
+}
+
+// NewAggregateExp creates the aggregate function call with the given arguments, every argument is
+// a column, an expression or a query.
+func NewAggregateExp(function string, args ...any) *AggregateExpression {
+	exp := &AggregateExpression{
+		function: function,
+		args:     EmptyDirectListExp(),
+		order:    EmptyReversedListExp(),
+		within:   EmptyReversedListExp(),
+		filter:   EmptyCondExp(),
+	}
+	for _, arg := range args {
+		exp.args.Append(arg)
+	}
+	return exp
+}
+
+func Count(args ...any) *AggregateExpression {
+	return NewAggregateExp("count", args...)
+}
+
+func Sum(arg any) *AggregateExpression {
+	return NewAggregateExp("sum", arg)
+}
+
+func Avg(arg any) *AggregateExpression {
+	return NewAggregateExp("avg", arg)
+}
+
+func Min(arg any) *AggregateExpression {
+	return NewAggregateExp("min", arg)
+}
+
+func Max(arg any) *AggregateExpression {
+	return NewAggregateExp("max", arg)
+}
+
+func ArrayAgg(arg any) *AggregateExpression {
+	return NewAggregateExp("array_agg", arg)
+}
+
+func StringAgg(arg any, delimiter any) *AggregateExpression {
+	return NewAggregateExp("string_agg", arg, delimiter)
+}
+
+// Distinct makes the aggregate to process only distinct values of its arguments.
+func (e *AggregateExpression) Distinct() *AggregateExpression {
+	e.distinct = true
+	return e
+}
+
+// OrderBy adds column name and its order to the ORDER BY inside the aggregate call:
+//   - OrderBy(column any)
+//   - OrderBy(column any, order any)
+func (e *AggregateExpression) OrderBy(column any, args ...any) *AggregateExpression {
+	e.order.Append(column, args...)
+	return e
+}
+
+// WithinGroup adds column name and its order to the WITHIN GROUP clause of the ordered-set aggregate:
+//   - WithinGroup(column any)
+//   - WithinGroup(column any, order any)
+func (e *AggregateExpression) WithinGroup(column any, args ...any) *AggregateExpression {
+	e.within.Append(column, args...)
+	return e
+}
+
+// Filter adds "AND" condition to the FILTER clause of the aggregate:
+//   - Filter(condition string)
+//   - Filter(condition ConditionalExpression)
+//   - Filter(column string, operator string, value any)
+//   - Filter(operand any, operator string, value any)
+//   - Filter(operator string, operand any)
+func (e *AggregateExpression) Filter(args ...any) *AggregateExpression {
+	e.filter.AndWhere(args...)
+	return e
+}
+
+// OrFilter adds "OR" condition to the FILTER clause of the aggregate, see Filter.
+func (e *AggregateExpression) OrFilter(args ...any) *AggregateExpression {
+	e.filter.OrWhere(args...)
+	return e
+}
+
+func (e *AggregateExpression) Copy() *AggregateExpression {
+	return &AggregateExpression{
+		function: e.function,
+		distinct: e.distinct,
+		args:     e.args.Copy(),
+		order:    e.order.Copy(),
+		within:   e.within.Copy(),
+		filter:   e.filter.Copy(),
+	}
+}
+
+// Exp returns the expression of the aggregate call.
+func (e *AggregateExpression) Exp() Expression {
+	exp := EmptyExp()
+	var sql strings.Builder
+	sql.WriteString(e.function)
+	sql.WriteByte('(')
+	if e.distinct {
+		sql.WriteString("DISTINCT ")
+	}
+	sql.WriteString(exp.expressionToString(e.args.Expression))
+	if e.order.IsNotEmpty() {
+		sql.WriteString(" ORDER BY ")
+		sql.WriteString(exp.expressionToString(e.order.Expression))
+	}
+	sql.WriteByte(')')
+	if e.within.IsNotEmpty() {
+		sql.WriteString(" WITHIN GROUP (ORDER BY ")
+		sql.WriteString(exp.expressionToString(e.within.Expression))
+		sql.WriteByte(')')
+	}
+	if e.filter.IsNotEmpty() {
+		sql.WriteString(" FILTER (WHERE ")
+		sql.WriteString(exp.expressionToString(e.filter.Expression))
+		sql.WriteByte(')')
+	}
+	exp.AddSql(sql.String())
+	return exp
+}
+
+func (e *AggregateExpression) String() string {
+	return e.Exp().String()
+}
+
+func (e *AggregateExpression) Params() map[string]any {
+	return e.Exp().Params()
+}
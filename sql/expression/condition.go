@@ -103,6 +103,19 @@ func (e ConditionalExpression) Where(args ...any) ConditionalExpression {
 	return e
 }
 
+// GlobalIn adds the ClickHouse "AND operand GLOBAL IN values" condition which sends the subquery result
+// or the value list to all remote servers of the distributed query:
+//   - GlobalIn(operand any, values []any)
+//   - GlobalIn(operand any, query sqb.Query)
+func (e ConditionalExpression) GlobalIn(operand any, values any) ConditionalExpression {
+	return e.Where(operand, "GLOBAL IN", values)
+}
+
+// GlobalNotIn adds the ClickHouse "AND operand GLOBAL NOT IN values" condition, see GlobalIn.
+func (e ConditionalExpression) GlobalNotIn(operand any, values any) ConditionalExpression {
+	return e.Where(operand, "GLOBAL NOT IN", values)
+}
+
 func (e ConditionalExpression) nameToString(exp any) string {
 	if exp == nil {
 		return "NULL"
@@ -112,6 +125,8 @@ func (e ConditionalExpression) nameToString(exp any) string {
 		return e.expressionToString(exp.(Expression))
 	case ConditionalExpression:
 		return e.conditionToString(exp.(ConditionalExpression))
+	case *AggregateExpression:
+		return e.expressionToString(exp.(*AggregateExpression).Exp())
 	case sqb.Query:
 		return e.queryToString(exp.(sqb.Query))
 	case []any:
@@ -154,6 +169,8 @@ func (e ConditionalExpression) valueToString(exp any, operator string) string {
 	switch exp.(type) {
 	case Expression:
 		return e.expressionToString(exp.(Expression))
+	case *AggregateExpression:
+		return e.expressionToString(exp.(*AggregateExpression).Exp())
 	case sqb.Query:
 		return e.queryToString(exp.(sqb.Query))
 	case []any:
@@ -0,0 +1,116 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlephTav/sqb"
+)
+
+// CteExpression is the definition of a common table expression with its column names, materialization
+// and the SEARCH and CYCLE clauses of recursive queries, e.g.
+// tree(id, parent_id) AS MATERIALIZED (SELECT ...) SEARCH DEPTH FIRST BY id SET ordercol.
+type CteExpression struct {
+	name         string
+	columns      []string
+	query        any
+	materialized string
+	search       string
+	cycle        string
+}
+
+// NewCteExp creates the definition of the common table expression, the query is a statement,
+// an expression or a raw SQL string.
+func NewCteExp(name string, query any) *CteExpression {
+	return &CteExpression{name: name, query: query}
+}
+
+// Columns sets the column names of the common table expression.
+func (e *CteExpression) Columns(columns ...string) *CteExpression {
+	e.columns = columns
+	return e
+}
+
+// Materialized forces the common table expression to be computed once.
+func (e *CteExpression) Materialized() *CteExpression {
+	e.materialized = "MATERIALIZED"
+	return e
+}
+
+// NotMaterialized allows the common table expression to be folded into the parent query.
+func (e *CteExpression) NotMaterialized() *CteExpression {
+	e.materialized = "NOT MATERIALIZED"
+	return e
+}
+
+// SearchDepthFirst adds the SEARCH DEPTH FIRST BY clause computing the ordering column of the recursive query,
+// e.g. SearchDepthFirst("ordercol", "id") is SEARCH DEPTH FIRST BY id SET ordercol.
+func (e *CteExpression) SearchDepthFirst(set string, by ...string) *CteExpression {
+	e.search = "SEARCH DEPTH FIRST BY " + strings.Join(by, ", ") + " SET " + set
+	return e
+}
+
+// SearchBreadthFirst adds the SEARCH BREADTH FIRST BY clause computing the ordering column of the recursive query,
+// e.g. SearchBreadthFirst("ordercol", "id") is SEARCH BREADTH FIRST BY id SET ordercol.
+func (e *CteExpression) SearchBreadthFirst(set string, by ...string) *CteExpression {
+	e.search = "SEARCH BREADTH FIRST BY " + strings.Join(by, ", ") + " SET " + set
+	return e
+}
+
+// Cycle adds the CYCLE clause detecting cycles of the recursive query by the given columns,
+// e.g. Cycle("is_cycle", "path", "id") is CYCLE id SET is_cycle USING path.
+func (e *CteExpression) Cycle(set string, using string, columns ...string) *CteExpression {
+	e.cycle = "CYCLE " + strings.Join(columns, ", ") + " SET " + set + " USING " + using
+	return e
+}
+
+func (e *CteExpression) Copy() *CteExpression {
+	exp := *e
+	exp.columns = append([]string(nil), e.columns...)
+	return &exp
+}
+
+// Exp returns the expression of the common table expression definition.
+func (e *CteExpression) Exp() Expression {
+	exp := EmptyExp()
+	exp.AddSql(e.name)
+	if len(e.columns) > 0 {
+		exp.AddSql("(" + strings.Join(e.columns, ", ") + ")")
+	}
+	exp.AddSql(" AS ")
+	if e.materialized != "" {
+		exp.AddSql(e.materialized)
+		exp.AddSql(" ")
+	}
+	exp.AddSql(exp.cteQueryToString(e.query))
+	if e.search != "" {
+		exp.AddSql(" ")
+		exp.AddSql(e.search)
+	}
+	if e.cycle != "" {
+		exp.AddSql(" ")
+		exp.AddSql(e.cycle)
+	}
+	return exp
+}
+
+func (e *CteExpression) String() string {
+	return e.Exp().String()
+}
+
+func (e *CteExpression) Params() map[string]any {
+	return e.Exp().Params()
+}
+
+func (e Expression) cteQueryToString(query any) string {
+	switch query.(type) {
+	case nil:
+		return "NULL"
+	case Expression:
+		return e.expressionToString(query.(Expression))
+	case sqb.Query:
+		return e.queryToString(query.(sqb.Query))
+	default:
+		return fmt.Sprintf("%s", query)
+	}
+}
@@ -0,0 +1,60 @@
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AlephTav/sqb"
+)
+
+// DdlToString returns the SQL of the value of DDL statements, e.g. column defaults or check constraints,
+// where parameters cannot be bound:
+//   - strings are SQL as is
+//   - nil, booleans, numbers and times are SQL literals, times are quoted and in UTC
+//   - expressions and queries must not have parameters, queries are enclosed in parentheses
+//   - values of other types are not SQL, so they are rejected
+func DdlToString(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return v, nil
+	case bool:
+		if v {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case ConditionalExpression:
+		return ddlWithoutParams(v.String(), v.Params())
+	case Expression:
+		return ddlWithoutParams(v.String(), v.Params())
+	case sqb.Query:
+		return ddlWithoutParams("("+v.String()+")", NestedParams(v))
+	case interface {
+		String() string
+		Params() map[string]any
+	}:
+		return ddlWithoutParams(v.String(), v.Params())
+	case time.Time:
+		return "'" + v.UTC().Format("2006-01-02 15:04:05.999999999") + "'", nil
+	default:
+		return "", fmt.Errorf("value of type %T cannot be used in DDL statement", value)
+	}
+}
+
+func ddlWithoutParams(sql string, params map[string]any) (string, error) {
+	if err, ok := params[nestedErrorParam].(error); ok {
+		return sql, err
+	}
+	if len(params) > 0 {
+		return sql, fmt.Errorf("DDL expression %q cannot have parameters, use SQL literals instead", sql)
+	}
+	return sql, nil
+}
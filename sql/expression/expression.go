@@ -5,6 +5,43 @@ import (
 	"strings"
 )
 
+// ParameterPlaceholder is the parameter value writing its own placeholder instead of :name,
+// e.g. the typed parameters of ClickHouse are written as {name:Type}.
+type ParameterPlaceholder interface {
+	Placeholder(name string) string
+}
+
+// nestedErrorParam is the key of the parameters carrying the error of the invalid nested statement up to
+// the statement embedding it, the statement fails with the error instead of adding it to its parameters.
+const nestedErrorParam = "\x00error"
+
+// NestedParams returns the parameters of the nested query or statement, if the nested statement is invalid
+// the parameters carry its error, see PopNestedError.
+func NestedParams(statement interface{ Params() map[string]any }) map[string]any {
+	params := statement.Params()
+	v, ok := statement.(interface{ Validate() error })
+	if !ok {
+		return params
+	}
+	err := v.Validate()
+	if err == nil {
+		return params
+	}
+	result := make(map[string]any, len(params)+1)
+	for k, v := range params {
+		result[k] = v
+	}
+	result[nestedErrorParam] = err
+	return result
+}
+
+// PopNestedError removes the error of the nested statement from the parameters and returns it.
+func PopNestedError(params map[string]any) error {
+	err, _ := params[nestedErrorParam].(error)
+	delete(params, nestedErrorParam)
+	return err
+}
+
 type Expression struct {
 	sql    *strings.Builder
 	params map[string]any
@@ -76,6 +113,9 @@ func (e Expression) Copy() Expression {
 func (e Expression) nextParameterName(value any) string {
 	var param = sqb.NextParameterName()
 	e.params[param] = value
+	if placeholder, ok := value.(ParameterPlaceholder); ok {
+		return placeholder.Placeholder(param)
+	}
 	return ":" + param
 }
 
@@ -90,6 +130,6 @@ func (e Expression) conditionToString(exp ConditionalExpression) string {
 }
 
 func (e Expression) queryToString(exp sqb.Query) string {
-	e.AddParams(exp.Params())
+	e.AddParams(NestedParams(exp))
 	return "(" + exp.String() + ")"
 }
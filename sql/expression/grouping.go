@@ -0,0 +1,51 @@
+package sql
+
+import "strings"
+
+// Grouping returns the GROUPING function call telling which of the columns are aggregated in the grouping set,
+// e.g. Grouping("a", "b") is GROUPING(a, b).
+func Grouping(columns ...any) Expression {
+	return functionOfColumns("GROUPING", columns)
+}
+
+// Rollup returns the grouping element of the hierarchy of grouping sets, e.g. Rollup("a", "b") is ROLLUP(a, b).
+func Rollup(columns ...any) Expression {
+	return functionOfColumns("ROLLUP", columns)
+}
+
+// Cube returns the grouping element of all combinations of the columns, e.g. Cube("a", "b") is CUBE(a, b).
+func Cube(columns ...any) Expression {
+	return functionOfColumns("CUBE", columns)
+}
+
+// GroupingSets returns the grouping element of the given sets, every set is a column, an expression
+// or a slice of them, the empty set is nil or an empty slice:
+//   - GroupingSets([]any{"a"}, []any{"a", "b"}, nil) is GROUPING SETS ((a), (a, b), ())
+func GroupingSets(sets ...any) Expression {
+	exp := EmptyExp()
+	items := make([]string, 0, len(sets))
+	for _, set := range sets {
+		columns := EmptyColumnListExp()
+		switch set.(type) {
+		case nil:
+		case []any:
+			for _, column := range set.([]any) {
+				columns.Append(column)
+			}
+		default:
+			columns.Append(set)
+		}
+		exp.AddParams(columns.Params())
+		items = append(items, "("+columns.String()+")")
+	}
+	exp.AddSql("GROUPING SETS (" + strings.Join(items, ", ") + ")")
+	return exp
+}
+
+func functionOfColumns(function string, columns []any) Expression {
+	list := EmptyColumnListExp()
+	for _, column := range columns {
+		list.Append(column)
+	}
+	return NewExpWithParams(function+"("+list.String()+")", list.Params())
+}
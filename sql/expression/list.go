@@ -45,6 +45,12 @@ func (e ListExpression) nameToString(exp any) string {
 		return e.expressionToString(exp.(Expression))
 	case ConditionalExpression:
 		return e.conditionToString(exp.(ConditionalExpression))
+	case LateralExpression:
+		return e.expressionToString(exp.(LateralExpression).Expression)
+	case *AggregateExpression:
+		return e.expressionToString(exp.(*AggregateExpression).Exp())
+	case *TableFunctionExpression:
+		return e.expressionToString(exp.(*TableFunctionExpression).Exp())
 	case ValueListExpression:
 		return e.valueListExpressionToString(exp.(ValueListExpression))
 	case sqb.Query:
@@ -121,3 +127,31 @@ func (e ListExpression) addToResult(alias any, name any, separator string, resul
 		result.WriteString(aliasAsString)
 	}
 }
+
+// SplitList splits the comma separated list of expressions ignoring commas inside parentheses and quotes.
+func SplitList(list string) []string {
+	var items []string
+	var quote rune
+	depth, start := 0, 0
+	for i, r := range list {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			items = append(items, strings.TrimSpace(list[start:i]))
+			start = i + 1
+		}
+	}
+	if item := strings.TrimSpace(list[start:]); item != "" || len(items) > 0 {
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,29 @@
+package sql
+
+import "strings"
+
+// TableAliasExpression is the alias of a derived table or a table function with the list of its column names,
+// e.g. AS v(id, a).
+type TableAliasExpression struct {
+	name    string
+	columns []string
+}
+
+func NewTableAliasExp(name string, columns ...string) TableAliasExpression {
+	return TableAliasExpression{name, columns}
+}
+
+func (e TableAliasExpression) Name() string {
+	return e.name
+}
+
+func (e TableAliasExpression) Columns() []string {
+	return e.columns
+}
+
+func (e TableAliasExpression) String() string {
+	if len(e.columns) == 0 {
+		return "AS " + e.name
+	}
+	return "AS " + e.name + "(" + strings.Join(e.columns, ", ") + ")"
+}
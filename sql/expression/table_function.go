@@ -0,0 +1,105 @@
+package sql
+
+import (
+	"strings"
+
+	"github.com/AlephTav/sqb"
+)
+
+// TableFunctionExpression is the call of a set-returning function used as a table source with
+// the optional WITH ORDINALITY modifier and the alias with the column definition list,
+// e.g. jsonb_to_recordset(:p1) AS x(a int, b text).
+type TableFunctionExpression struct {
+	call       Expression
+	ordinality bool
+	alias      *TableAliasExpression
+}
+
+// NewTableFunctionExp creates the table function call, the arguments are bound as parameters
+// except expressions and queries:
+//   - NewTableFunctionExp("generate_series", 1, 10) is generate_series(:p1, :p2)
+//   - NewTableFunctionExp("jsonb_array_elements", sql.NewExp("t.data")) is jsonb_array_elements(t.data)
+func NewTableFunctionExp(function string, args ...any) *TableFunctionExpression {
+	call := EmptyExp()
+	items := make([]string, 0, len(args))
+	for _, arg := range args {
+		items = append(items, call.argumentToString(arg))
+	}
+	call.AddSql(function + "(" + strings.Join(items, ", ") + ")")
+	return &TableFunctionExpression{call: call}
+}
+
+// WithOrdinality appends the column of the row numbers to the function result.
+func (e *TableFunctionExpression) WithOrdinality() *TableFunctionExpression {
+	e.ordinality = true
+	return e
+}
+
+// As sets the alias of the function result with the list of column names or column definitions,
+// e.g. As("x", "a int", "b text").
+func (e *TableFunctionExpression) As(alias string, columns ...string) *TableFunctionExpression {
+	exp := NewTableAliasExp(alias, columns...)
+	e.alias = &exp
+	return e
+}
+
+func (e *TableFunctionExpression) Copy() *TableFunctionExpression {
+	exp := *e
+	exp.call = e.call.Copy()
+	return &exp
+}
+
+// Exp returns the expression of the function call.
+func (e *TableFunctionExpression) Exp() Expression {
+	exp := EmptyExp()
+	exp.AddSql(exp.expressionToString(e.call))
+	if e.ordinality {
+		exp.AddSql(" WITH ORDINALITY")
+	}
+	if e.alias != nil {
+		exp.AddSql(" ")
+		exp.AddSql(e.alias.String())
+	}
+	return exp
+}
+
+func (e *TableFunctionExpression) String() string {
+	return e.Exp().String()
+}
+
+func (e *TableFunctionExpression) Params() map[string]any {
+	return e.Exp().Params()
+}
+
+func (e Expression) argumentToString(arg any) string {
+	if arg == nil {
+		return "NULL"
+	}
+	switch arg.(type) {
+	case Expression:
+		return e.expressionToString(arg.(Expression))
+	case sqb.Query:
+		return e.queryToString(arg.(sqb.Query))
+	}
+	return e.nextParameterName(arg)
+}
+
+// Lateral returns the LATERAL table source which can refer to columns of preceding items of the FROM list,
+// the source is a query or a table function:
+//   - Lateral(query) is LATERAL (SELECT ...)
+//   - Lateral(NewTableFunctionExp("generate_series", 1, 10)) is LATERAL generate_series(:p1, :p2)
+func Lateral(source any) LateralExpression {
+	list := NewColumnListExp(source)
+	return LateralExpression{NewExpWithParams("LATERAL "+list.String(), list.Params()), source}
+}
+
+// LateralExpression is the LATERAL table source, see Lateral.
+type LateralExpression struct {
+	Expression
+	source any
+}
+
+// Source returns the query or the table function of the LATERAL table source.
+func (e LateralExpression) Source() any {
+	return e.source
+}
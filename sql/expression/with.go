@@ -44,6 +44,8 @@ func (e WithExpression) nameToString(exp any) string {
 	switch exp.(type) {
 	case Expression:
 		return e.expressionToString(exp.(Expression))
+	case *CteExpression:
+		return e.expressionToString(exp.(*CteExpression).Exp())
 	case sqb.Query:
 		return e.queryToString(exp.(sqb.Query))
 	case []any:
@@ -68,7 +70,7 @@ func (e WithExpression) sliceToString(exp []any) string {
 			query = value
 		}
 		e.addToResult(alias, query, separator, &result)
-		separator = " AND "
+		separator = ", "
 	}
 	return result.String()
 }
@@ -77,9 +79,8 @@ func (e WithExpression) mapToString(exp sqb.SliceMap) string {
 	var separator string
 	var result strings.Builder
 	for i, count := 0, len(exp); i < count; i += 2 {
-		result.WriteString(separator)
 		e.addToResult(exp[i], exp[i+1], separator, &result)
-		separator = " AND "
+		separator = ", "
 	}
 	return result.String()
 }
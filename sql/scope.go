@@ -0,0 +1,14 @@
+package sql
+
+// Scope is a reusable part of a statement definition, e.g. a set of joins and conditions shared by repositories.
+type Scope[T any] func(T) T
+
+// Scopes composes the scopes into one scope applying them in the given order.
+func Scopes[T any](scopes ...Scope[T]) Scope[T] {
+	return func(st T) T {
+		for _, scope := range scopes {
+			st = scope(st)
+		}
+		return st
+	}
+}
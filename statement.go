@@ -16,6 +16,19 @@ type Statement[T any] interface {
 	Copy() T
 }
 
+// FailingStatement is the optional capability of a statement to keep the error found while it is built,
+// the invalid statement is not executed.
+type FailingStatement[T any] interface {
+	Fail(err error) T
+}
+
+// Fail marks the statement as invalid if it is FailingStatement.
+func Fail[T any](statement T, err error) {
+	if s, ok := any(statement).(FailingStatement[T]); ok {
+		s.Fail(err)
+	}
+}
+
 type ColumnsAwareStmt[T any] interface {
 	Statement[T]
 	Columns(columns any) T
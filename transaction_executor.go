@@ -0,0 +1,8 @@
+package sqb
+
+// TransactionExecutor is the optional capability of a StatementExecutor to execute statements in a transaction.
+type TransactionExecutor interface {
+	// Transaction executes the callback in a transaction that is committed if the callback returns nil
+	// and rolled back otherwise.
+	Transaction(callback func(db StatementExecutor) error) error
+}